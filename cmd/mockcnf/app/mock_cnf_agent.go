@@ -47,7 +47,9 @@ import (
 	abx "go.pantheon.tech/stonework/plugins/abx"
 	cnfreg_plugin "go.pantheon.tech/stonework/plugins/cnfreg"
 	isisx "go.pantheon.tech/stonework/plugins/isisx"
+	linuxcp "go.pantheon.tech/stonework/plugins/linuxcp"
 	mockcnf_plugin "go.pantheon.tech/stonework/plugins/mockcnf"
+	ndproxy "go.pantheon.tech/stonework/plugins/ndproxy"
 	puntmgr_plugin "go.pantheon.tech/stonework/plugins/puntmgr"
 	"go.pantheon.tech/stonework/proto/cnfreg"
 	"go.pantheon.tech/stonework/proto/mockcnf"
@@ -167,6 +169,8 @@ type VPP struct {
 	L3Plugin *l3plugin.L3Plugin
 	ABX      *abx.ABXPlugin
 	ISISX    *isisx.ISISXPlugin
+	NDProxy  *ndproxy.NDProxyPlugin
+	LinuxCP  *linuxcp.LinuxCPPlugin
 }
 
 func DefaultVPP() VPP {
@@ -176,6 +180,8 @@ func DefaultVPP() VPP {
 		L3Plugin: &l3plugin.DefaultPlugin,
 		ABX:      &abx.DefaultPlugin,
 		ISISX:    &isisx.DefaultPlugin,
+		NDProxy:  &ndproxy.DefaultPlugin,
+		LinuxCP:  &linuxcp.DefaultPlugin,
 	}
 }
 
@@ -35,7 +35,9 @@ import (
 	_ "go.pantheon.tech/stonework/proto/abx"
 	_ "go.pantheon.tech/stonework/proto/bfd"
 	_ "go.pantheon.tech/stonework/proto/isisx"
+	_ "go.pantheon.tech/stonework/proto/linuxcp"
 	_ "go.pantheon.tech/stonework/proto/nat64"
+	_ "go.pantheon.tech/stonework/proto/ndproxy"
 )
 
 var printSpec = flag.CommandLine.Bool("print-spec", false,
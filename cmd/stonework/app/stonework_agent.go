@@ -54,7 +54,9 @@ import (
 	bfd "go.pantheon.tech/stonework/plugins/bfd"
 	"go.pantheon.tech/stonework/plugins/cnfreg"
 	isisxplugin "go.pantheon.tech/stonework/plugins/isisx"
+	linuxcpplugin "go.pantheon.tech/stonework/plugins/linuxcp"
 	nat64plugin "go.pantheon.tech/stonework/plugins/nat64"
+	ndproxyplugin "go.pantheon.tech/stonework/plugins/ndproxy"
 	"go.pantheon.tech/stonework/plugins/puntmgr"
 )
 
@@ -95,6 +97,8 @@ func New() *StoneWorkAgent {
 		etcdDataSync,
 	))
 	orchestrator.DefaultPlugin.Watcher = watchers
+	// NB transactions committed with retry (required by proxied items of SW-Modules)
+	orchestrator.DefaultPlugin.KVScheduler = cnfreg.DefaultPlugin.NBTxnScheduler()
 	orchestrator.DefaultPlugin.StatusPublisher = writers
 	orchestrator.EnabledGrpcMetrics()
 
@@ -172,6 +176,8 @@ type VPP struct {
 	ABX         *abx.ABXPlugin
 	ISISX       *isisxplugin.ISISXPlugin
 	BFD         *bfd.BfdPlugin
+	NDProxy     *ndproxyplugin.NDProxyPlugin
+	LinuxCP     *linuxcpplugin.LinuxCPPlugin
 }
 
 func DefaultVPP() VPP {
@@ -190,6 +196,8 @@ func DefaultVPP() VPP {
 		ABX:         &abx.DefaultPlugin,
 		ISISX:       &isisxplugin.DefaultPlugin,
 		BFD:         &bfd.DefaultPlugin,
+		NDProxy:     &ndproxyplugin.DefaultPlugin,
+		LinuxCP:     &linuxcpplugin.DefaultPlugin,
 	}
 }
 
@@ -8,4 +8,6 @@ import (
 	_ "go.pantheon.tech/stonework/proto/abx"
 	_ "go.pantheon.tech/stonework/proto/bfd"
 	_ "go.pantheon.tech/stonework/proto/isisx"
+	_ "go.pantheon.tech/stonework/proto/linuxcp"
+	_ "go.pantheon.tech/stonework/proto/ndproxy"
 )
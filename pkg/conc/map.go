@@ -41,6 +41,18 @@ func (m Map[K, V]) Set(key K, val V) {
 	m.data[key] = val
 }
 
+// GetOrSet returns the existing value for the key if present, otherwise stores and returns the given value.
+// The loaded result is true if the value was loaded, false if stored.
+func (m Map[K, V]) GetOrSet(key K, val V) (actual V, loaded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if actual, loaded = m.data[key]; loaded {
+		return actual, true
+	}
+	m.data[key] = val
+	return val, false
+}
+
 func (m Map[K, V]) Del(key K) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
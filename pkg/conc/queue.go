@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conc
+
+import "sync"
+
+// KeyedQueue executes submitted functions asynchronously. Functions submitted under the same key
+// are executed one after another in the order of submission, functions with different keys in parallel.
+// There is at most one goroutine per key, which exits once there is nothing more to execute for the key.
+type KeyedQueue[K comparable] struct {
+	mu     *sync.Mutex
+	queues map[K][]func()
+}
+
+func NewKeyedQueue[K comparable]() KeyedQueue[K] {
+	return KeyedQueue[K]{mu: &sync.Mutex{}, queues: make(map[K][]func())}
+}
+
+// Submit schedules execution of the function. The returned channel is closed once the function returns.
+func (q KeyedQueue[K]) Submit(key K, fn func()) <-chan struct{} {
+	done := make(chan struct{})
+	task := func() {
+		defer close(done)
+		fn()
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	pending, running := q.queues[key]
+	q.queues[key] = append(pending, task)
+	if !running {
+		go q.run(key)
+	}
+	return done
+}
+
+func (q KeyedQueue[K]) run(key K) {
+	for {
+		q.mu.Lock()
+		tasks := q.queues[key]
+		if len(tasks) == 0 {
+			delete(q.queues, key)
+			q.mu.Unlock()
+			return
+		}
+		task := tasks[0]
+		q.queues[key] = tasks[1:]
+		q.mu.Unlock()
+		task()
+	}
+}
@@ -0,0 +1,290 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	"google.golang.org/protobuf/proto"
+)
+
+// at most this many changes are sent to the SW-Module in a single remote transaction
+const maxChangeBatchSize = 1000
+
+// states of values reported by the SW-Module for which the change is considered failed
+var failedValueStates = map[string]struct{}{
+	"FAILED":   {},
+	"INVALID":  {},
+	"RETRYING": {},
+}
+
+// remoteChange is a change of a single value proxied to the SW-Module.
+type remoteChange struct {
+	value     proto.Message
+	valueHash [sha256.Size]byte
+	remove    bool
+}
+
+// matches returns true if both changes lead to the same state of the value.
+func (c remoteChange) matches(other remoteChange) bool {
+	if c.remove || other.remove {
+		return c.remove == other.remove
+	}
+	return c.valueHash == other.valueHash
+}
+
+type changeResult struct {
+	change remoteChange
+	err    error
+}
+
+// changeBatch applies changes of values proxied to a SW-Module (from all its batched models) from a NB
+// transaction as a single remote transaction, before the NB transaction is committed into KVScheduler
+// (see nbTxn.Commit). Proxy descriptors then return the stored result of the change instead of sending it.
+// Changes not executed by KVScheduler (e.g. value with unmet dependencies) are reverted once the NB
+// transaction is finished.
+type changeBatch struct {
+	sync.Mutex
+	apply     func(changes map[string]remoteChange) (errs map[string]error)
+	maxSize   int
+	selectors []kvs.KeySelector       // keys of batched models
+	results   map[string]changeResult // key -> result of the change not claimed by a proxy descriptor yet
+}
+
+func newChangeBatch(module *moduleProxy) *changeBatch {
+	return &changeBatch{
+		apply:   module.applyChanges,
+		maxSize: maxChangeBatchSize,
+		results: make(map[string]changeResult),
+	}
+}
+
+// addModel makes changes of values of the model batched. Models with punts or extra dependencies are not
+// batched, their values must not reach the SW-Module before KVScheduler has prepared what they need.
+func (b *changeBatch) addModel(model models.KnownModel) {
+	b.Lock()
+	defer b.Unlock()
+	b.selectors = append(b.selectors, model.IsKeyValid)
+}
+
+// selects returns true if changes of the value are batched.
+func (b *changeBatch) selects(key string) bool {
+	b.Lock()
+	defer b.Unlock()
+	for _, selector := range b.selectors {
+		if selector(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// takeResult returns the stored result of the change (only once), applied is false if the change
+// was not applied ahead of the transaction.
+func (b *changeBatch) takeResult(key string, value proto.Message, remove bool) (applied bool, err error) {
+	change := remoteChange{value: value, remove: remove}
+	if !remove {
+		if change.valueHash, err = hashValue(value); err != nil {
+			// sent by the proxy descriptor
+			return false, nil
+		}
+	}
+	b.Lock()
+	defer b.Unlock()
+	result, stored := b.results[key]
+	if !stored || !result.change.matches(change) {
+		return false, nil
+	}
+	delete(b.results, key)
+	return true, result.err
+}
+
+// applyChangeBatches applies changes of values from the NB transaction in SW-Modules with batching enabled,
+// a single remote transaction per SW-Module. Returns SW-Modules with stored results of the changes.
+func (p *Plugin) applyChangeBatches(values map[string]proto.Message) (batched []*moduleProxy) {
+	var wg sync.WaitGroup
+	for kv := range p.sw.proxies.Iter() {
+		m := kv.Val
+		if m.batch == nil {
+			continue
+		}
+		changes := m.batchChanges(values)
+		if len(changes) == 0 {
+			continue
+		}
+		batched = append(batched, m)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs := m.sendBatch(changes)
+			m.batch.Lock()
+			defer m.batch.Unlock()
+			for key, change := range changes {
+				m.batch.results[key] = changeResult{change: change, err: errs[key]}
+			}
+		}()
+	}
+	wg.Wait()
+	return batched
+}
+
+// batchChanges returns changes of batched values from the NB transaction (nil value = removed),
+// values already configured in the SW-Module are skipped.
+func (m *moduleProxy) batchChanges(values map[string]proto.Message) map[string]remoteChange {
+	m.Lock()
+	defer m.Unlock()
+	if !m.available {
+		return nil
+	}
+	changes := make(map[string]remoteChange)
+	for key, value := range values {
+		if !m.batch.selects(key) {
+			continue
+		}
+		configured, isConfigured := m.values[key]
+		if value == nil {
+			if isConfigured {
+				changes[key] = remoteChange{value: configured, remove: true}
+			}
+			continue
+		}
+		if isConfigured && proto.Equal(configured, value) {
+			continue
+		}
+		valueHash, err := hashValue(value)
+		if err != nil {
+			// sent by the proxy descriptor
+			continue
+		}
+		changes[key] = remoteChange{value: value, valueHash: valueHash}
+	}
+	return changes
+}
+
+// sendBatch applies the changes in the SW-Module, split into remote transactions of at most maxSize changes.
+func (m *moduleProxy) sendBatch(changes map[string]remoteChange) (errs map[string]error) {
+	keys := make([]string, 0, len(changes))
+	for key := range changes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	errs = make(map[string]error)
+	for len(keys) > 0 {
+		size := len(keys)
+		if size > m.batch.maxSize {
+			size = m.batch.maxSize
+		}
+		chunk := make(map[string]remoteChange, size)
+		for _, key := range keys[:size] {
+			chunk[key] = changes[key]
+		}
+		keys = keys[size:]
+		for key, err := range m.batch.apply(chunk) {
+			errs[key] = err
+		}
+	}
+	return errs
+}
+
+// revertBatch reverts changes applied ahead of the NB transaction which were not executed by KVScheduler
+// (their results were not claimed), so that the SW-Module has the values known to the proxy.
+func (m *moduleProxy) revertBatch() {
+	m.batch.Lock()
+	unclaimed := m.batch.results
+	m.batch.results = make(map[string]changeResult)
+	m.batch.Unlock()
+
+	m.Lock()
+	reverts := make(map[string]remoteChange)
+	for key, result := range unclaimed {
+		configured, isConfigured := m.values[key]
+		switch {
+		case isConfigured && (result.change.remove || !proto.Equal(configured, result.change.value)):
+			reverts[key] = remoteChange{value: configured}
+		case !isConfigured && !result.change.remove:
+			reverts[key] = remoteChange{value: result.change.value, remove: true}
+		}
+	}
+	m.Unlock()
+	if len(reverts) == 0 {
+		return
+	}
+	for key, err := range m.sendBatch(reverts) {
+		m.log.Warnf("failed to revert change of %s not executed by KVScheduler: %v", key, err)
+	}
+}
+
+// applyChanges applies the changes in the SW-Module as a single transaction and returns errors
+// of the changes that have failed, key = value key.
+func (m *moduleProxy) applyChanges(changes map[string]remoteChange) (errs map[string]error) {
+	errs = make(map[string]error)
+	m.Lock()
+	manager, available := m.manager, m.available
+	m.Unlock()
+	if !available {
+		for key := range changes {
+			errs[key] = fmt.Errorf("%w: %s", ErrCnfNotLoaded, m.cnfMsLabel)
+		}
+		return errs
+	}
+	req := &generic.SetConfigRequest{}
+	for key, change := range changes {
+		item, err := models.MarshalItem(change.value)
+		if err != nil {
+			errs[key] = fmt.Errorf("failed to marshal proto message into Item: %w", err)
+			continue
+		}
+		if change.remove {
+			item.Data = nil
+		}
+		req.Updates = append(req.Updates, &generic.UpdateItem{Item: item})
+	}
+	if len(req.Updates) == 0 {
+		return errs
+	}
+	var resp *generic.SetConfigResponse
+	err := m.call("SetConfig", func(ctx context.Context) (err error) {
+		resp, err = manager.SetConfig(ctx, req)
+		return err
+	})
+	if err != nil {
+		for key := range changes {
+			if errs[key] == nil {
+				errs[key] = err
+			}
+		}
+		return errs
+	}
+	for _, result := range resp.GetResults() {
+		if _, changed := changes[result.GetKey()]; !changed {
+			// derived value
+			continue
+		}
+		if _, failed := failedValueStates[result.GetStatus().GetStatus()]; failed {
+			errs[result.GetKey()] = errors.New(result.GetStatus().GetMessage())
+		}
+	}
+	return errs
+}
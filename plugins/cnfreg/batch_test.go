@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.pantheon.tech/stonework/pkg/conc"
+)
+
+// fakeModule applies batches of changes instead of the SW-Module.
+type fakeModule struct {
+	sync.Mutex
+	proxy   *moduleProxy
+	applied []map[string]remoteChange
+	errs    map[string]error // key -> error returned for the change
+}
+
+func newFakeModule() *fakeModule {
+	m := &fakeModule{errs: make(map[string]error)}
+	m.proxy = newModuleProxy(logging.ForPlugin("cnfreg-test"), "test", &Config{BatchProxiedChanges: true})
+	m.proxy.available = true
+	m.proxy.batch.apply = m.apply
+	m.proxy.batch.selectors = append(m.proxy.batch.selectors, func(key string) bool {
+		return strings.HasPrefix(key, "config/")
+	})
+	return m
+}
+
+func (m *fakeModule) apply(changes map[string]remoteChange) map[string]error {
+	m.Lock()
+	defer m.Unlock()
+	errs := make(map[string]error)
+	for key := range changes {
+		if err := m.errs[key]; err != nil {
+			errs[key] = err
+		}
+	}
+	m.applied = append(m.applied, changes)
+	return errs
+}
+
+// takeApplied returns batches of changes applied in the SW-Module since the last call.
+func (m *fakeModule) takeApplied() []map[string]remoteChange {
+	m.Lock()
+	defer m.Unlock()
+	applied := m.applied
+	m.applied = nil
+	return applied
+}
+
+// commit applies batch of changes of the NB transaction the same way as nbTxn.Commit.
+func (m *fakeModule) commit(values map[string]proto.Message) []*moduleProxy {
+	p := testDiscoveryPlugin()
+	p.sw.proxies = conc.NewMap[string, *moduleProxy]()
+	p.sw.proxies.Set(m.proxy.cnfMsLabel, m.proxy)
+	return p.applyChangeBatches(values)
+}
+
+func stringValue(change remoteChange) string {
+	return change.value.(*wrapperspb.StringValue).GetValue()
+}
+
+func TestChangeBatchResults(t *testing.T) {
+	RegisterTestingT(t)
+	module := newFakeModule()
+	errInvalid := errors.New("invalid value")
+	module.errs["config/updated"] = errInvalid
+	module.proxy.setValue("config/unchanged", wrapperspb.String("unchanged"))
+	module.proxy.setValue("config/updated", wrapperspb.String("old"))
+	module.proxy.setValue("config/removed", wrapperspb.String("removed"))
+
+	batched := module.commit(map[string]proto.Message{
+		"config/new":       wrapperspb.String("new"),
+		"config/unchanged": wrapperspb.String("unchanged"),
+		"config/updated":   wrapperspb.String("updated"),
+		"config/removed":   nil,
+		"config/absent":    nil,
+		"other/new":        wrapperspb.String("not batched"),
+	})
+	Expect(batched).To(ConsistOf(module.proxy))
+	applied := module.takeApplied()
+	Expect(applied).To(HaveLen(1))
+	Expect(applied[0]).To(HaveLen(3))
+	Expect(stringValue(applied[0]["config/new"])).To(Equal("new"))
+	Expect(stringValue(applied[0]["config/updated"])).To(Equal("updated"))
+	Expect(applied[0]["config/removed"].remove).To(BeTrue())
+
+	// the result of a different change is not returned
+	claimed, _ := module.proxy.batch.takeResult("config/new", wrapperspb.String("other"), false)
+	Expect(claimed).To(BeFalse())
+	claimed, _ = module.proxy.batch.takeResult("config/removed", wrapperspb.String("removed"), false)
+	Expect(claimed).To(BeFalse())
+
+	// per-key results are returned only once
+	for _, step := range []struct {
+		key     string
+		value   proto.Message
+		remove  bool
+		wantErr error
+	}{
+		{key: "config/new", value: wrapperspb.String("new")},
+		{key: "config/updated", value: wrapperspb.String("updated"), wantErr: errInvalid},
+		{key: "config/removed", value: wrapperspb.String("removed"), remove: true},
+	} {
+		applied, err := module.proxy.batch.takeResult(step.key, step.value, step.remove)
+		Expect(applied).To(BeTrue(), step.key)
+		if step.wantErr != nil {
+			Expect(err).To(MatchError(step.wantErr), step.key)
+		} else {
+			Expect(err).ToNot(HaveOccurred(), step.key)
+		}
+		applied, _ = module.proxy.batch.takeResult(step.key, step.value, step.remove)
+		Expect(applied).To(BeFalse(), step.key)
+	}
+	Expect(module.proxy.batch.results).To(BeEmpty())
+}
+
+func TestChangeBatchRevert(t *testing.T) {
+	RegisterTestingT(t)
+	module := newFakeModule()
+	module.proxy.setValue("config/updated", wrapperspb.String("old"))
+	module.proxy.setValue("config/removed", wrapperspb.String("removed"))
+
+	batched := module.commit(map[string]proto.Message{
+		"config/claimed": wrapperspb.String("claimed"),
+		"config/new":     wrapperspb.String("new"),
+		"config/updated": wrapperspb.String("updated"),
+		"config/removed": nil,
+	})
+	Expect(module.takeApplied()).To(HaveLen(1))
+
+	// only the change of config/claimed is executed by KVScheduler
+	module.proxy.setValue("config/claimed", wrapperspb.String("claimed"))
+	applied, err := module.proxy.batch.takeResult("config/claimed", wrapperspb.String("claimed"), false)
+	Expect(applied).To(BeTrue())
+	Expect(err).ToNot(HaveOccurred())
+
+	// unclaimed changes are reverted to the values known to the proxy
+	for _, m := range batched {
+		m.revertBatch()
+	}
+	reverts := module.takeApplied()
+	Expect(reverts).To(HaveLen(1))
+	Expect(reverts[0]).To(HaveLen(3))
+	Expect(reverts[0]["config/new"].remove).To(BeTrue())
+	Expect(stringValue(reverts[0]["config/new"])).To(Equal("new"))
+	Expect(reverts[0]["config/updated"].remove).To(BeFalse())
+	Expect(stringValue(reverts[0]["config/updated"])).To(Equal("old"))
+	Expect(reverts[0]["config/removed"].remove).To(BeFalse())
+	Expect(stringValue(reverts[0]["config/removed"])).To(Equal("removed"))
+	Expect(module.proxy.batch.results).To(BeEmpty())
+
+	// nothing to revert
+	module.proxy.revertBatch()
+	Expect(module.takeApplied()).To(BeEmpty())
+}
+
+func TestChangeBatchMaxSize(t *testing.T) {
+	RegisterTestingT(t)
+	module := newFakeModule()
+	module.proxy.batch.maxSize = 2
+
+	values := make(map[string]proto.Message)
+	for _, key := range []string{"config/a", "config/b", "config/c", "config/d", "config/e"} {
+		values[key] = wrapperspb.String(key)
+	}
+	module.commit(values)
+	applied := module.takeApplied()
+	Expect(applied).To(HaveLen(3))
+	Expect(applied[0]).To(HaveLen(2))
+	Expect(applied[1]).To(HaveLen(2))
+	Expect(applied[2]).To(HaveLen(1))
+	Expect(module.proxy.batch.results).To(HaveLen(len(values)))
+}
+
+func TestChangeBatchUnavailable(t *testing.T) {
+	RegisterTestingT(t)
+	module := newFakeModule()
+	module.proxy.disconnect()
+
+	// changes are sent by proxy descriptors (which fail for unavailable SW-Module)
+	Expect(module.commit(map[string]proto.Message{"config/a": wrapperspb.String("a")})).To(BeEmpty())
+	Expect(module.takeApplied()).To(BeEmpty())
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+)
+
+var (
+	// ErrModuleTimeout is returned when SW-Module does not respond to RPC within the configured deadline.
+	ErrModuleTimeout = errors.New("SW-Module has not responded in time")
+	// ErrModuleUnhealthy is returned without calling the SW-Module while its circuit breaker is open,
+	// i.e. after repeated timeouts.
+	ErrModuleUnhealthy = errors.New("SW-Module is unhealthy")
+)
+
+// circuitBreaker stops calls to SW-Module that repeatedly does not respond in time. Once open, calls fail
+// immediately until the cooldown elapses, then a single trial call is let through - if it succeeds,
+// the breaker closes, if it times out, the breaker stays open for another cooldown.
+type circuitBreaker struct {
+	sync.Mutex
+	threshold int // consecutive timeouts that open the breaker, <= 0 = disabled
+	cooldown  time.Duration
+	timeouts  int // consecutive timeouts
+	openUntil time.Time
+	trial     bool // trial call is in progress
+	now       func() time.Time
+}
+
+// allow returns ErrModuleUnhealthy if the call should not be made.
+func (b *circuitBreaker) allow() error {
+	b.Lock()
+	defer b.Unlock()
+	if b.threshold <= 0 || b.timeouts < b.threshold {
+		return nil
+	}
+	if b.trial || b.now().Before(b.openUntil) {
+		return ErrModuleUnhealthy
+	}
+	b.trial = true
+	return nil
+}
+
+// record updates the breaker with the result of a call. Returns true if the breaker has just opened or closed.
+func (b *circuitBreaker) record(err error) (changed bool) {
+	b.Lock()
+	defer b.Unlock()
+	wasOpen := b.threshold > 0 && b.timeouts >= b.threshold
+	b.trial = false
+	if errors.Is(err, ErrModuleTimeout) {
+		b.timeouts++
+		if b.threshold > 0 && b.timeouts >= b.threshold {
+			b.openUntil = b.now().Add(b.cooldown)
+			return !wasOpen
+		}
+		return false
+	}
+	b.timeouts = 0
+	return wasOpen
+}
+
+// isOpen returns true if the SW-Module is considered unhealthy.
+func (b *circuitBreaker) isOpen() bool {
+	b.Lock()
+	defer b.Unlock()
+	return b.threshold > 0 && b.timeouts >= b.threshold
+}
+
+// call invokes RPC of the SW-Module with the RPC deadline, unless the circuit breaker of the SW-Module is open.
+// Missed deadline is reported as ErrModuleTimeout.
+func (m *moduleProxy) call(rpc string, fn func(ctx context.Context) error) error {
+	if err := m.breaker.allow(); err != nil {
+		return fmt.Errorf("%w: %s (%s is not called)", err, m.cnfMsLabel, rpc)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), m.rpcTimeout)
+	defer cancel()
+	err := fn(ctx)
+	if errors.Is(err, context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded {
+		err = fmt.Errorf("%w: %s did not complete within %v: %v", ErrModuleTimeout, rpc, m.rpcTimeout, err)
+	}
+	if m.breaker.record(err) {
+		if m.breaker.isOpen() {
+			m.log.Warnf("SW-Module %s is unhealthy (repeated timeouts), calls are suspended for %v",
+				m.cnfMsLabel, m.breaker.cooldown)
+		} else {
+			m.log.Infof("SW-Module %s is responding again", m.cnfMsLabel)
+		}
+	}
+	return err
+}
+
+// isRetriableFailure returns false for failures of proxied operations which would fail the same way
+// if retried (e.g. value refused by the SW-Module). Timeouts, unhealthy or unavailable SW-Module
+// are retriable.
+func isRetriableFailure(err error) bool {
+	var invalidValErr *kvs.InvalidValueError
+	if errors.As(err, &invalidValErr) {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.Unimplemented,
+		codes.PermissionDenied, codes.Unauthenticated:
+		return false
+	}
+	return true
+}
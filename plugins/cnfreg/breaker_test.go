@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+const testBreakerCooldown = 10 * time.Second
+
+// breakerStep is a single call of the SW-Module guarded by the circuit breaker.
+type breakerStep struct {
+	advance     time.Duration // fake clock is moved forward before the call
+	err         error         // result of the call (recorded only if the call is allowed)
+	inProgress  bool          // the call is allowed but its result is not recorded yet
+	recordOnly  bool          // records the result of the call in progress (allow is not called)
+	wantAllowed bool
+	wantChanged bool // breaker has just opened or closed
+	wantOpen    bool
+}
+
+var (
+	errTestTimeout = fmt.Errorf("%w: test", ErrModuleTimeout)
+	errTestInvalid = errors.New("invalid value")
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold int
+		steps     []breakerStep
+	}{
+		{
+			name:      "opens after threshold timeouts",
+			threshold: 2,
+			steps: []breakerStep{
+				{err: errTestTimeout, wantAllowed: true},
+				{err: errTestTimeout, wantAllowed: true, wantChanged: true, wantOpen: true},
+				{wantOpen: true},
+				{advance: testBreakerCooldown - time.Second, wantOpen: true},
+			},
+		},
+		{
+			name:      "success resets consecutive timeouts",
+			threshold: 2,
+			steps: []breakerStep{
+				{err: errTestTimeout, wantAllowed: true},
+				{wantAllowed: true},
+				{err: errTestTimeout, wantAllowed: true},
+				{err: errTestInvalid, wantAllowed: true},
+				{err: errTestTimeout, wantAllowed: true},
+			},
+		},
+		{
+			name:      "other errors are not counted",
+			threshold: 1,
+			steps: []breakerStep{
+				{err: errTestInvalid, wantAllowed: true},
+				{err: errTestInvalid, wantAllowed: true},
+				{err: errTestTimeout, wantAllowed: true, wantChanged: true, wantOpen: true},
+			},
+		},
+		{
+			name:      "successful trial closes the breaker",
+			threshold: 1,
+			steps: []breakerStep{
+				{err: errTestTimeout, wantAllowed: true, wantChanged: true, wantOpen: true},
+				{advance: testBreakerCooldown / 2, wantOpen: true},
+				{advance: testBreakerCooldown / 2, wantAllowed: true, wantChanged: true},
+				{wantAllowed: true},
+			},
+		},
+		{
+			name:      "failed trial reopens the breaker for another cooldown",
+			threshold: 1,
+			steps: []breakerStep{
+				{err: errTestTimeout, wantAllowed: true, wantChanged: true, wantOpen: true},
+				{advance: testBreakerCooldown, err: errTestTimeout, wantAllowed: true, wantOpen: true},
+				{advance: testBreakerCooldown - time.Second, wantOpen: true},
+				{advance: time.Second, err: errTestInvalid, wantAllowed: true, wantChanged: true},
+			},
+		},
+		{
+			name:      "single trial call at a time",
+			threshold: 1,
+			steps: []breakerStep{
+				{err: errTestTimeout, wantAllowed: true, wantChanged: true, wantOpen: true},
+				{advance: testBreakerCooldown, inProgress: true, wantAllowed: true, wantOpen: true},
+				{wantOpen: true},
+				{advance: testBreakerCooldown, wantOpen: true},
+				{recordOnly: true, wantChanged: true},
+				{wantAllowed: true},
+			},
+		},
+		{
+			name:      "negative threshold disables the breaker",
+			threshold: -1,
+			steps: []breakerStep{
+				{err: errTestTimeout, wantAllowed: true},
+				{err: errTestTimeout, wantAllowed: true},
+				{err: errTestTimeout, wantAllowed: true},
+			},
+		},
+		{
+			name: "zero threshold disables the breaker",
+			steps: []breakerStep{
+				{err: errTestTimeout, wantAllowed: true},
+				{err: errTestTimeout, wantAllowed: true},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			now := time.Now()
+			b := &circuitBreaker{
+				threshold: test.threshold,
+				cooldown:  testBreakerCooldown,
+				now:       func() time.Time { return now },
+			}
+			for i, step := range test.steps {
+				now = now.Add(step.advance)
+				if !step.recordOnly {
+					err := b.allow()
+					if step.wantAllowed {
+						Expect(err).ToNot(HaveOccurred(), "step %d", i)
+					} else {
+						Expect(err).To(MatchError(ErrModuleUnhealthy), "step %d", i)
+					}
+				}
+				if step.recordOnly || (step.wantAllowed && !step.inProgress) {
+					Expect(b.record(step.err)).To(Equal(step.wantChanged), "step %d", i)
+				}
+				Expect(b.isOpen()).To(Equal(step.wantOpen), "step %d", i)
+			}
+		})
+	}
+}
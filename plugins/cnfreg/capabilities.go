@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "go.pantheon.tech/stonework/proto/cnfreg"
+	"go.pantheon.tech/stonework/proto/puntmgr"
+)
+
+const (
+	// ApiVersion is the version of the API between StoneWork and SW-Modules implemented by this CNF Registry.
+	// Peers that predate the capability negotiation are treated as version 0.
+	ApiVersion = 1
+	// MinApiVersion is the oldest API version of the peer that this CNF Registry is able to work with.
+	MinApiVersion = 0
+
+	// optional RPCs of SW-Modules used by StoneWork
+	rpcGetPuntRequests     = "/cnfreg.CnfDiscovery/GetPuntRequests"
+	rpcGetItemDependencies = "/cnfreg.CnfDiscovery/GetItemDependencies"
+	rpcValidateItem        = "/cnfreg.CnfDiscovery/ValidateItem"
+	rpcGetItemsInfo        = "/cnfreg.CnfDiscovery/GetItemsInfo"
+	rpcGetStateItems       = "/cnfreg.CnfDiscovery/GetStateItems"
+)
+
+// Compatibility of SW-Module with StoneWork, as determined by the capability negotiation.
+const (
+	// SW-Module can be fully used.
+	CompatOK = "compatible"
+	// SW-Module is loaded, but some of its features are disabled.
+	CompatDegraded = "degraded"
+	// SW-Module predates the capability negotiation and is used as is.
+	CompatLegacy = "legacy"
+	// SW-Module is not loaded.
+	CompatRejected = "rejected"
+)
+
+// ErrIncompatibleApi is returned when StoneWork and SW-Module do not support API version of each other.
+var ErrIncompatibleApi = errors.New("incompatible API version")
+
+// moduleCompat is the result of the capability negotiation with a SW-Module.
+type moduleCompat struct {
+	apiVersion uint32
+	status     string
+	// why the SW-Module is degraded or rejected
+	issues []string
+	// punt and interconnect types usable by both sides
+	puntTypes map[puntmgr.PuntRequest_PuntType]struct{}
+	icTypes   map[puntmgr.PuntRequest_InterconnectType]struct{}
+	// SW-Module serves the batched GetItemsInfo
+	withItemsInfo bool
+}
+
+// checkPunt returns error if the punt cannot be used with the SW-Module.
+func (c *moduleCompat) checkPunt(puntReq *puntmgr.PuntRequest) error {
+	if c == nil {
+		return nil
+	}
+	if _, usable := c.puntTypes[puntReq.GetPuntType()]; !usable {
+		return fmt.Errorf("punt type %v is not supported by both StoneWork and SW-Module", puntReq.GetPuntType())
+	}
+	if _, usable := c.icTypes[puntReq.GetInterconnectType()]; !usable {
+		return fmt.Errorf("interconnect type %v is not supported by both StoneWork and SW-Module",
+			puntReq.GetInterconnectType())
+	}
+	return nil
+}
+
+// capabilities returns API capabilities of this CNF Registry (and Punt Manager used with it).
+// Optional RPCs of SW-Modules are advertised only if they are backed by registered callbacks.
+// In the STONEWORK_MODULE mode the method should be called with swMod locked.
+func (p *Plugin) capabilities() *pb.ApiCapabilities {
+	caps := &pb.ApiCapabilities{
+		ApiVersion:    ApiVersion,
+		MinApiVersion: MinApiVersion,
+	}
+	if p.PuntMgr != nil {
+		caps.PuntTypes, caps.InterconnectTypes = p.PuntMgr.GetSupportedPunts()
+	}
+	if grpcServer := p.GRPCPlugin.GetServer(); grpcServer != nil {
+		optionalRpcs := p.servedOptionalRpcs()
+		for service, info := range grpcServer.GetServiceInfo() {
+			for _, method := range info.Methods {
+				rpc := "/" + service + "/" + method.Name
+				if served, optional := optionalRpcs[rpc]; optional && !served {
+					// registered with the service, but there is nothing to serve
+					continue
+				}
+				caps.Rpcs = append(caps.Rpcs, rpc)
+			}
+		}
+		sort.Strings(caps.Rpcs)
+	}
+	return caps
+}
+
+// servedOptionalRpcs returns for every optional RPC of SW-Modules whether it is actually served,
+// i.e. whether some exposed model has registered the callback used by the RPC.
+func (p *Plugin) servedOptionalRpcs() map[string]bool {
+	served := map[string]bool{
+		rpcGetPuntRequests:     false,
+		rpcGetItemDependencies: false,
+		rpcValidateItem:        false,
+		rpcGetItemsInfo:        false,
+		rpcGetStateItems:       len(p.swMod.stateModels) > 0,
+	}
+	for _, expModel := range p.swMod.models {
+		callbacks := expModel.callbacks
+		if callbacks == nil {
+			continue
+		}
+		if callbacks.PuntRequests != nil {
+			served[rpcGetPuntRequests] = true
+			served[rpcGetItemsInfo] = true
+		}
+		if callbacks.ItemDependencies != nil {
+			served[rpcGetItemDependencies] = true
+			served[rpcGetItemsInfo] = true
+		}
+		if callbacks.Validate != nil {
+			served[rpcValidateItem] = true
+		}
+	}
+	return served
+}
+
+// checkApiVersion returns error if API version of the peer is not supported or if the peer does not support
+// the API version implemented by this CNF Registry.
+func checkApiVersion(peerCaps *pb.ApiCapabilities) error {
+	if peerCaps.GetApiVersion() < MinApiVersion {
+		return fmt.Errorf("%w: peer implements API version %d, at least %d is required",
+			ErrIncompatibleApi, peerCaps.GetApiVersion(), MinApiVersion)
+	}
+	if ApiVersion < peerCaps.GetMinApiVersion() {
+		return fmt.Errorf("%w: peer requires API version %d or newer, %d is implemented",
+			ErrIncompatibleApi, peerCaps.GetMinApiVersion(), ApiVersion)
+	}
+	return nil
+}
+
+// negotiate determines how StoneWork can use the SW-Module with the given capabilities (nil if not reported).
+// Error is returned if the SW-Module is incompatible. Models of SW-Module which rely on RPCs not served
+// by the SW-Module are degraded to not use them.
+func (p *Plugin) negotiate(swMod *swModule, modCaps *pb.ApiCapabilities) error {
+	swCaps := p.capabilities()
+	compat := &moduleCompat{
+		status:    CompatOK,
+		puntTypes: make(map[puntmgr.PuntRequest_PuntType]struct{}),
+		icTypes:   make(map[puntmgr.PuntRequest_InterconnectType]struct{}),
+	}
+	swMod.compat = compat
+	if modCaps == nil {
+		// assume that the SW-Module uses only what StoneWork supports
+		compat.status = CompatLegacy
+		compat.issues = append(compat.issues, "SW-Module does not report API capabilities")
+		compat.puntTypes = toSet(swCaps.GetPuntTypes())
+		compat.icTypes = toSet(swCaps.GetInterconnectTypes())
+		return nil
+	}
+	compat.apiVersion = modCaps.GetApiVersion()
+	if err := checkApiVersion(modCaps); err != nil {
+		compat.status = CompatRejected
+		compat.issues = append(compat.issues, err.Error())
+		return err
+	}
+
+	// punts can use only types supported by both sides
+	swPuntTypes := toSet(swCaps.GetPuntTypes())
+	var unsupported []string
+	for _, puntType := range modCaps.GetPuntTypes() {
+		if _, supported := swPuntTypes[puntType]; supported {
+			compat.puntTypes[puntType] = struct{}{}
+		} else {
+			unsupported = append(unsupported, puntType.String())
+		}
+	}
+	if len(unsupported) > 0 {
+		compat.issues = append(compat.issues, "punt types not supported by StoneWork: "+
+			strings.Join(unsupported, ", "))
+	}
+	swIcTypes := toSet(swCaps.GetInterconnectTypes())
+	unsupported = nil
+	for _, icType := range modCaps.GetInterconnectTypes() {
+		if _, supported := swIcTypes[icType]; supported {
+			compat.icTypes[icType] = struct{}{}
+		} else {
+			unsupported = append(unsupported, icType.String())
+		}
+	}
+	if len(unsupported) > 0 {
+		compat.issues = append(compat.issues, "interconnect types not supported by StoneWork: "+
+			strings.Join(unsupported, ", "))
+	}
+
+	// do not call RPCs which are not served by the SW-Module
+	modRpcs := toSet(modCaps.GetRpcs())
+	_, compat.withItemsInfo = modRpcs[rpcGetItemsInfo]
+	for i := range swMod.cnfModels {
+		model := &swMod.cnfModels[i]
+		if _, served := modRpcs[rpcGetPuntRequests]; model.withPunt && !served {
+			model.withPunt = false
+			compat.issues = append(compat.issues, fmt.Sprintf("punts of model %s are disabled "+
+				"(GetPuntRequests is not served)", model.info.ProtoName))
+		}
+		if _, served := modRpcs[rpcGetItemDependencies]; model.withDeps && !served {
+			model.withDeps = false
+			compat.issues = append(compat.issues, fmt.Sprintf("dependencies of model %s are disabled "+
+				"(GetItemDependencies is not served)", model.info.ProtoName))
+		}
+		if _, served := modRpcs[rpcValidateItem]; model.withValidate && !served {
+			model.withValidate = false
+			compat.issues = append(compat.issues, fmt.Sprintf("validation of model %s is disabled "+
+				"(ValidateItem is not served)", model.info.ProtoName))
+		}
+	}
+	if _, served := modRpcs[rpcGetStateItems]; len(swMod.stateModels) > 0 && !served {
+		compat.issues = append(compat.issues, fmt.Sprintf("%d state models are disabled "+
+			"(GetStateItems is not served)", len(swMod.stateModels)))
+		swMod.stateModels = nil
+	}
+	if len(compat.issues) > 0 {
+		compat.status = CompatDegraded
+		p.Log.Warnf("SW-Module %s is degraded: %s", swMod.cnfMsLabel, strings.Join(compat.issues, "; "))
+	}
+	return nil
+}
+
+func toSet[T comparable](items []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(items))
+	for _, item := range items {
+		set[item] = struct{}{}
+	}
+	return set
+}
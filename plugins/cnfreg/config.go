@@ -16,13 +16,36 @@
 
 package cnfreg
 
-import "time"
+import (
+	"errors"
+	"time"
+)
 
 const (
 	// Start of the gRPC port range for StoneWork modules
 	defaultSwModGrpcBasePort = 19000
 	// Start of the HTTP port range for StoneWork modules
 	defaultSwModHttpBasePort = 19100
+	// How often to check by default that SW-Modules are alive
+	defaultModuleCheckPeriod = 5 * time.Second
+	// How long by default can the gRPC connection with SW-Module fail before the module is considered departed
+	defaultModuleFailureTimeout = 30 * time.Second
+	// How often to poll discovery backends other than pid files by default
+	defaultDiscoveryPollInterval = 10 * time.Second
+	// How long by default is registration of SW-Module through the CnfRegistry service valid without heartbeat
+	defaultRegistrationTTL = 15 * time.Second
+	// How long by default does SW-Module wait for StoneWork to lease CNF index
+	defaultLeaseTimeout = 5 * time.Second
+	// File in which StoneWork persists CNF indexes leased to SW-Modules by default
+	defaultLeaseFile = "/run/stonework/cnfreg/leases.json"
+	// How long by default is CNF index kept leased to SW-Module which is not loaded
+	defaultLeaseTTL = 24 * time.Hour
+	// Deadline used by default for calls of SW-Modules made by StoneWork
+	defaultRPCTimeout = 10 * time.Second
+	// Number of consecutive timeouts after which SW-Module is considered unhealthy by default
+	defaultBreakerThreshold = 3
+	// How long are calls of unhealthy SW-Module suspended by default
+	defaultBreakerCooldown = 30 * time.Second
 )
 
 // Config file for CnfRegistry plugin.
@@ -33,13 +56,95 @@ type Config struct {
 	SwModHttpBasePort int `json:"sw-module-http-base-port"`
 	// Deprecated. Using this option will result in a warning
 	CnfDiscoveryTimeout time.Duration `json:"cnf-discovery-timeout"`
+	// How often to check that SW-Modules are alive
+	ModuleCheckPeriod time.Duration `json:"module-check-period"`
+	// How long can the gRPC connection with SW-Module fail before the module is considered departed
+	ModuleFailureTimeout time.Duration `json:"module-failure-timeout"`
+	// Consider SW-Module departed once its process (PID from the pid file) is not running.
+	// Requires StoneWork to share the PID namespace with SW-Modules.
+	ModulePidCheck bool `json:"module-pid-check"`
+	// Mutual TLS authentication between StoneWork and SW-Modules (disabled if not configured).
+	// SW-Module serves gRPC with the configured certificate and requires StoneWork to present a client certificate.
+	// gRPC server of StoneWork should be configured for TLS in grpc.conf (cert-file, key-file, ca-files).
+	TLS *TLSConfig `json:"tls"`
+	// Address (IP or hostname, e.g. K8s service name) advertised to other CNFs instead of the discovered
+	// management IP address.
+	AdvertisedAddress string `json:"advertised-address"`
+	// Discovery of SW-Modules through the Kubernetes API (used by StoneWork in addition to pid files).
+	K8sDiscovery *K8sDiscoveryConfig `json:"k8s-discovery"`
+	// Discovery of SW-Modules through DNS SRV records (used by StoneWork in addition to pid files).
+	DNSDiscovery *DNSDiscoveryConfig `json:"dns-discovery"`
+	// How long is registration of SW-Module through the CnfRegistry service valid without heartbeat
+	// (used by StoneWork). SW-Modules are asked to send heartbeats 3 times within this period.
+	RegistrationTTL time.Duration `json:"registration-ttl"`
+	// gRPC address (host:port) of StoneWork (used by SW-Module). If configured, SW-Module registers itself
+	// through the CnfRegistry service of StoneWork instead of writing the pid file.
+	StoneWorkAddress string `json:"stonework-address"`
+	// Lease CNF index (and thus gRPC and HTTP ports) from StoneWork during Init instead of using the static
+	// CnfIndex (used by SW-Module). The static CnfIndex, if defined, is used when StoneWork is unreachable.
+	LeaseCnfIndex bool `json:"lease-cnf-index"`
+	// How long to wait for StoneWork to lease CNF index (used by SW-Module).
+	LeaseTimeout time.Duration `json:"lease-timeout"`
+	// File in which CNF indexes leased to SW-Modules are persisted (used by StoneWork, empty = not persisted).
+	LeaseFile string `json:"lease-file"`
+	// How long is CNF index kept leased to SW-Module which is not loaded into StoneWork (used by StoneWork).
+	// Expired lease is released and the index can be leased to another SW-Module.
+	LeaseTTL time.Duration `json:"lease-ttl"`
+	// Apply changes of values proxied to the same SW-Module within a NB transaction in the SW-Module as a single
+	// transaction, before the NB transaction is committed (used by StoneWork). Proxy descriptors then return
+	// the results of the changes. Requires NB transactions committed through NBTxnScheduler.
+	BatchProxiedChanges bool `json:"batch-proxied-changes"`
+	// Deadline for every call of a SW-Module made by StoneWork (proxied operations, discovery).
+	// Timeouts are reported to KVScheduler as retriable failures.
+	RPCTimeout time.Duration `json:"rpc-timeout"`
+	// Number of consecutive timeouts after which SW-Module is considered unhealthy and its calls are suspended
+	// (failing immediately with ErrModuleUnhealthy). Negative value disables the circuit breaker.
+	BreakerThreshold int `json:"breaker-threshold"`
+	// How long are calls of unhealthy SW-Module suspended before a single trial call is let through.
+	BreakerCooldown time.Duration `json:"breaker-cooldown"`
+	// Reverse proxy of REST endpoints of SW-Modules under /cnf/<ms-label>/ on the HTTP server of StoneWork
+	// (disabled if not configured).
+	HTTPProxy *HTTPProxyConfig `json:"http-proxy"`
+	// Proxy of custom gRPC services of SW-Modules served by StoneWork on a dedicated endpoint
+	// (disabled if not configured).
+	GRPCProxy *GRPCProxyConfig `json:"grpc-proxy"`
+}
+
+// K8sDiscoveryConfig configures discovery of SW-Module pods through the Kubernetes API.
+// Pods are selected by labels and must be annotated with the gRPC port (see K8sAnnotationGrpcPort).
+type K8sDiscoveryConfig struct {
+	// Namespace of SW-Module pods (default = namespace of StoneWork).
+	Namespace string `json:"namespace"`
+	// Label selector of SW-Module pods (default = all pods of the namespace).
+	LabelSelector string `json:"label-selector"`
+	// How often to list the pods.
+	PollInterval time.Duration `json:"poll-interval"`
+}
+
+// DNSDiscoveryConfig configures discovery of SW-Modules through DNS SRV records (e.g. of a headless K8s service).
+type DNSDiscoveryConfig struct {
+	// Name of SRV records with gRPC ports of SW-Modules (e.g. "_grpc._tcp.sw-modules.default.svc.cluster.local").
+	GrpcService string `json:"grpc-service"`
+	// Name of SRV records with HTTP ports of SW-Modules (optional).
+	HttpService string `json:"http-service"`
+	// How often to resolve the records.
+	PollInterval time.Duration `json:"poll-interval"`
 }
 
 // loadConfig returns PuntMgr plugin file configuration if exists.
 func (p *Plugin) loadConfig() (*Config, error) {
 	cfg := &Config{
-		SwModGrpcBasePort: defaultSwModGrpcBasePort,
-		SwModHttpBasePort: defaultSwModHttpBasePort,
+		SwModGrpcBasePort:    defaultSwModGrpcBasePort,
+		SwModHttpBasePort:    defaultSwModHttpBasePort,
+		ModuleCheckPeriod:    defaultModuleCheckPeriod,
+		ModuleFailureTimeout: defaultModuleFailureTimeout,
+		RegistrationTTL:      defaultRegistrationTTL,
+		LeaseTimeout:         defaultLeaseTimeout,
+		LeaseFile:            defaultLeaseFile,
+		LeaseTTL:             defaultLeaseTTL,
+		RPCTimeout:           defaultRPCTimeout,
+		BreakerThreshold:     defaultBreakerThreshold,
+		BreakerCooldown:      defaultBreakerCooldown,
 	}
 	found, err := p.Cfg.LoadValue(cfg)
 	if err != nil {
@@ -51,6 +156,41 @@ func (p *Plugin) loadConfig() (*Config, error) {
 	}
 
 	p.Log.Debugf("CnfRegistry config found: %+v", cfg)
+	if cfg.ModuleCheckPeriod <= 0 {
+		cfg.ModuleCheckPeriod = defaultModuleCheckPeriod
+	}
+	if cfg.RegistrationTTL <= 0 {
+		cfg.RegistrationTTL = defaultRegistrationTTL
+	}
+	if cfg.LeaseTimeout <= 0 {
+		cfg.LeaseTimeout = defaultLeaseTimeout
+	}
+	if cfg.LeaseTTL <= 0 {
+		cfg.LeaseTTL = defaultLeaseTTL
+	}
+	if cfg.RPCTimeout <= 0 {
+		cfg.RPCTimeout = defaultRPCTimeout
+	}
+	if cfg.BreakerThreshold == 0 {
+		cfg.BreakerThreshold = defaultBreakerThreshold
+	}
+	if cfg.BreakerCooldown <= 0 {
+		cfg.BreakerCooldown = defaultBreakerCooldown
+	}
+	if cfg.K8sDiscovery != nil && cfg.K8sDiscovery.PollInterval <= 0 {
+		cfg.K8sDiscovery.PollInterval = defaultDiscoveryPollInterval
+	}
+	if cfg.GRPCProxy != nil && cfg.GRPCProxy.Endpoint == "" {
+		return nil, errors.New("gRPC proxy requires endpoint to be configured")
+	}
+	if cfg.DNSDiscovery != nil {
+		if cfg.DNSDiscovery.GrpcService == "" {
+			return nil, errors.New("DNS discovery requires grpc-service to be configured")
+		}
+		if cfg.DNSDiscovery.PollInterval <= 0 {
+			cfg.DNSDiscovery.PollInterval = defaultDiscoveryPollInterval
+		}
+	}
 	var durZeroVal time.Duration
 	if cfg.CnfDiscoveryTimeout != durZeroVal {
 		p.Log.Warn("Option CnfDiscoveryTimeout is deprecated and setting it has no effect. " +
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"errors"
+	"strings"
+	"syscall"
+	"time"
+
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+)
+
+const (
+	ModuleNotifDescriptorName = "cnf-module-notification"
+
+	moduleNotifKeyPrefix = "cnfreg/module/"
+)
+
+// ModuleAvailabilityKey returns key of the SB notification which is present while the given SW-Module
+// is loaded into StoneWork. All values proxied to the SW-Module depend on it.
+func ModuleAvailabilityKey(cnfMsLabel string) string {
+	return moduleNotifKeyPrefix + cnfMsLabel
+}
+
+// moduleNotifDescriptor describes notifications about availability of SW-Modules to KV Scheduler.
+type moduleNotifDescriptor struct {
+	log         logging.Logger
+	kvScheduler kvs.KVScheduler
+}
+
+func newModuleNotifDescriptor(kvScheduler kvs.KVScheduler, log logging.Logger) (
+	*moduleNotifDescriptor, *kvs.KVDescriptor) {
+	descr := &moduleNotifDescriptor{
+		log:         log,
+		kvScheduler: kvScheduler,
+	}
+	return descr, &kvs.KVDescriptor{
+		Name:        ModuleNotifDescriptorName,
+		KeySelector: descr.isModuleNotifKey,
+	}
+}
+
+func (d *moduleNotifDescriptor) isModuleNotifKey(key string) bool {
+	return strings.HasPrefix(key, moduleNotifKeyPrefix)
+}
+
+// notify publishes notification to KVScheduler when SW-Module is loaded and retracts it
+// when the SW-Module departs.
+func (d *moduleNotifDescriptor) notify(cnfMsLabel string, departed bool) {
+	var value proto.Message
+	if !departed {
+		// empty == available, nil == departed
+		value = &emptypb.Empty{}
+	}
+	err := d.kvScheduler.PushSBNotification(kvs.KVWithMetadata{
+		Key:   ModuleAvailabilityKey(cnfMsLabel),
+		Value: value,
+	})
+	if err != nil {
+		d.log.Warnf("failed to send notification to KVScheduler: %v", err)
+	}
+}
+
+// monitorModules periodically checks that all loaded SW-Modules are still alive.
+// SW-Module is considered departed if its registration has expired (only SW-Modules registered through
+// CnfRegistry service), if its process is not running anymore (only with ModulePidCheck enabled)
+// or if the gRPC connection with the SW-Module has been failing for longer than ModuleFailureTimeout.
+func (p *Plugin) monitorModules(done <-chan struct{}) {
+	failingSince := make(map[string]time.Time) // key = cnf microservice label
+	ticker := time.NewTicker(p.config.ModuleCheckPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			for kv := range p.sw.modules.Iter() {
+				swMod := kv.Val
+				if p.registrationExpired(swMod, now) {
+					delete(failingSince, swMod.cnfMsLabel)
+					p.removeModule(swMod, "registration has expired (no heartbeat)")
+					continue
+				}
+				if p.config.ModulePidCheck && !isProcessAlive(swMod.pid) {
+					delete(failingSince, swMod.cnfMsLabel)
+					p.removeModule(swMod, "process is not running")
+					continue
+				}
+				switch swMod.grpcConn.GetState() {
+				case connectivity.TransientFailure, connectivity.Shutdown:
+					since, failing := failingSince[swMod.cnfMsLabel]
+					if !failing {
+						failingSince[swMod.cnfMsLabel] = now
+						continue
+					}
+					if now.Sub(since) >= p.config.ModuleFailureTimeout {
+						delete(failingSince, swMod.cnfMsLabel)
+						p.removeModule(swMod, "gRPC connection is failing")
+					}
+				case connectivity.Idle:
+					// make sure that the connection is probed
+					swMod.grpcConn.Connect()
+					fallthrough
+				default:
+					delete(failingSince, swMod.cnfMsLabel)
+				}
+			}
+			p.expireCnfLeases(now)
+		}
+	}
+}
+
+// isProcessAlive returns false if there is definitely no process with the given PID.
+func isProcessAlive(pid int) bool {
+	if pid <= 0 {
+		return true
+	}
+	err := syscall.Kill(pid, 0)
+	return err == nil || !errors.Is(err, syscall.ESRCH)
+}
+
+// removeModule handles departure of the SW-Module. The registry entry is removed, values proxied
+// to the SW-Module are made unavailable (moved to the pending state by KVScheduler, which also
+// removes their punts) and the connection with the SW-Module is closed.
+func (p *Plugin) removeModule(swMod swModule, reason string) {
+	unlock := p.lockModule(swMod.cnfMsLabel)
+	defer unlock()
+	if loaded, isLoaded := p.sw.modules.Get(swMod.cnfMsLabel); !isLoaded || loaded.pid != swMod.pid ||
+		loaded.grpcConn != swMod.grpcConn {
+		// already removed or replaced
+		return
+	}
+	p.Log.Warnf("SW-Module %s (pid %d) has departed: %s", swMod.cnfMsLabel, swMod.pid, reason)
+	p.sw.modules.Del(swMod.cnfMsLabel)
+	swMod.proxy.disconnect()
+	p.sw.moduleNotif.notify(swMod.cnfMsLabel, true)
+	if err := swMod.grpcConn.Close(); err != nil {
+		p.Log.Warnf("failed to close gRPC connection with SW-Module %s: %v", swMod.cnfMsLabel, err)
+	}
+}
+
+// removeModuleBySource handles removal of the SW-Module by the discovery backend
+// (e.g. pid file was removed).
+func (p *Plugin) removeModuleBySource(source, reason string) {
+	for kv := range p.sw.modules.Iter() {
+		if kv.Val.source == source {
+			p.removeModule(kv.Val, reason)
+		}
+	}
+}
@@ -25,7 +25,9 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
@@ -33,6 +35,7 @@ import (
 	"google.golang.org/grpc"
 
 	"go.ligato.io/vpp-agent/v3/client/remoteclient"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
 
 	pb "go.pantheon.tech/stonework/proto/cnfreg"
 )
@@ -40,13 +43,16 @@ import (
 const (
 	pidFileDir = "/run/stonework/discovery"
 	pidFileExt = ".pid"
+
+	// timeout for replaying configuration into a restarted SW-Module
+	moduleResyncTimeout = time.Minute
 )
 
 // PidFile written by SW-Module CNF.
 type PidFile struct {
 	Pid       int    `json:"pid"`
 	MsLabel   string `json:"ms-label"`
-	IpAddress string `json:"ip-address"`
+	IpAddress string `json:"ip-address"` // IP address or hostname
 	GrpcPort  int    `json:"grpc-port"`
 	HttpPort  int    `json:"http-port"`
 }
@@ -74,14 +80,20 @@ func (p *Plugin) cnfDiscovery(done <-chan struct{}) {
 		p.Log.Errorf("failed to read pid file directory: %v", err)
 	}
 	for _, pf := range pidFiles {
+		if strings.HasSuffix(pf.Name(), leaseReqFileExt) {
+			p.handleLeaseRequest(pidFileDir + "/" + pf.Name())
+			continue
+		}
+		if !strings.HasSuffix(pf.Name(), pidFileExt) {
+			continue
+		}
 		swMod, err := p.loadSwModFromFile(pidFileDir + "/" + pf.Name())
 		if err != nil {
 			p.Log.Errorf("loading StoneWork module from file failed: %v", err)
 			continue
 		}
 		if _, ok := p.sw.modules.Get(swMod.cnfMsLabel); !ok {
-			p.sw.modules.Set(swMod.cnfMsLabel, swMod)
-			p.initCnfProxy(swMod)
+			p.addModule(swMod)
 		}
 	}
 
@@ -104,17 +116,151 @@ func (p *Plugin) discovery(w *fsnotify.Watcher) {
 				return
 			}
 
+			if ev.Has(fsnotify.Remove) || ev.Has(fsnotify.Rename) {
+				p.removeModuleBySource(ev.Name, "pid file was removed")
+				continue
+			}
 			if !ev.Has(fsnotify.Create) && !ev.Has(fsnotify.Write) {
 				continue
 			}
+			if strings.HasSuffix(ev.Name, leaseReqFileExt) {
+				p.handleLeaseRequest(ev.Name)
+				continue
+			}
+			if !strings.HasSuffix(ev.Name, pidFileExt) {
+				// e.g. lease file written by StoneWork
+				continue
+			}
 
 			swMod, err := p.loadSwModFromFile(ev.Name)
 			if err != nil {
 				p.Log.Errorf("loading StoneWork module from file failed: %v", err)
 				continue
 			}
-			p.sw.modules.Set(swMod.cnfMsLabel, swMod)
-			p.initCnfProxy(swMod)
+			p.addModule(swMod)
+		}
+	}
+}
+
+// addModule loads discovered SW-Module into StoneWork and makes values proxied to the module available.
+// SW-Module discovered with the microservice label of an already loaded SW-Module has restarted
+// (DiscoverCnf succeeds only once per process) - proxy descriptors are reused and the restarted SW-Module
+// is resynchronized: punt metadata are re-sent and the configuration proxied before the restart is replayed.
+// SW-Module that re-appears after departure gets its configuration re-created by KVScheduler
+// once it is announced as available again.
+func (p *Plugin) addModule(swMod swModule) {
+	unlock := p.lockModule(swMod.cnfMsLabel)
+	defer unlock()
+	p.loadModule(swMod)
+}
+
+// lockModule locks loading and removal of the SW-Module with the given microservice label
+// (discovery backends, registrations and the departure monitor run concurrently).
+// The returned function unlocks the SW-Module.
+func (p *Plugin) lockModule(cnfMsLabel string) (unlock func()) {
+	lock, _ := p.sw.moduleLocks.GetOrSet(cnfMsLabel, &sync.Mutex{})
+	lock.Lock()
+	return lock.Unlock
+}
+
+// loadModule implements addModule, the SW-Module has to be locked by the caller.
+func (p *Plugin) loadModule(swMod swModule) {
+	proxy, known := p.sw.proxies.Get(swMod.cnfMsLabel)
+	if !known {
+		proxy = newModuleProxy(p.Log.NewLogger(swMod.cnfMsLabel), swMod.cnfMsLabel, p.config)
+	}
+	swMod.proxy = proxy
+
+	// register proxy descriptors first, the SW-Module is published only once it can be used
+	if err := p.initCnfProxy(swMod); err != nil {
+		p.Log.Errorf("failed to load SW-Module %s: %v", swMod.cnfMsLabel, err)
+		if err := swMod.grpcConn.Close(); err != nil {
+			p.Log.Warnf("failed to close gRPC connection with SW-Module %s: %v", swMod.cnfMsLabel, err)
+		}
+		if !known && proxy.hasDescriptors() {
+			// registered descriptors are bound to the proxy (to be reused with the next attempt)
+			p.sw.proxies.Set(swMod.cnfMsLabel, proxy)
+		}
+		return
+	}
+	if !known {
+		p.sw.proxies.Set(swMod.cnfMsLabel, proxy)
+	}
+
+	// publish the SW-Module
+	p.sw.rejected.Del(swMod.cnfMsLabel)
+	prevMod, restarted := p.sw.modules.Get(swMod.cnfMsLabel)
+	p.sw.modules.Set(swMod.cnfMsLabel, swMod)
+	if restarted {
+		p.Log.Infof("SW-Module %s has restarted (pid %d -> %d)", swMod.cnfMsLabel, prevMod.pid, swMod.pid)
+		if err := prevMod.grpcConn.Close(); err != nil {
+			p.Log.Warnf("failed to close gRPC connection with SW-Module %s: %v", swMod.cnfMsLabel, err)
+		}
+	}
+	proxy.connect(swMod.cnfClient, swMod.cfgClient, generic.NewManagerServiceClient(swMod.grpcConn), swMod.compat)
+	if restarted {
+		p.resyncModule(swMod)
+	}
+	p.sw.moduleNotif.notify(swMod.cnfMsLabel, false)
+}
+
+// resyncModule brings restarted SW-Module up-to-date with the state of StoneWork.
+func (p *Plugin) resyncModule(swMod swModule) {
+	if err := p.PuntMgr.ResyncCnf(swMod.cnfMsLabel); err != nil {
+		p.Log.Warnf("failed to resync punts of SW-Module %s: %v", swMod.cnfMsLabel, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), moduleResyncTimeout)
+	defer cancel()
+	if err := swMod.proxy.replay(ctx); err != nil {
+		p.Log.Warnf("failed to replay configuration of SW-Module %s: %v", swMod.cnfMsLabel, err)
+		return
+	}
+	p.Log.Infof("Restarted SW-Module %s has been resynchronized", swMod.cnfMsLabel)
+}
+
+// discoveryLister returns SW-Modules currently found by a discovery backend, key = source identifier.
+type discoveryLister func(ctx context.Context) (map[string]PidFile, error)
+
+// pollDiscovery periodically lists SW-Modules using a discovery backend other than pid files.
+// Newly found (or changed) SW-Modules are loaded the same way as those found through pid files,
+// SW-Modules no longer listed are removed.
+func (p *Plugin) pollDiscovery(backend string, period time.Duration, list discoveryLister, done <-chan struct{}) {
+	loaded := make(map[string]PidFile) // key = source
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), period)
+		found, err := list(ctx)
+		cancel()
+		if err != nil {
+			p.Log.Errorf("%s discovery failed: %v", backend, err)
+		} else {
+			// (restarted SW-Module may be listed under a new source, add first to recognize the restart)
+			for source, pf := range found {
+				if prevPf, isLoaded := loaded[source]; isLoaded && prevPf == pf {
+					continue
+				}
+				swMod, err := p.loadSwMod(pf, source)
+				if err != nil {
+					// retried with the next poll
+					p.Log.Errorf("loading StoneWork module %s found by %s discovery failed: %v",
+						source, backend, err)
+					continue
+				}
+				loaded[source] = pf
+				p.addModule(swMod)
+			}
+			for source := range loaded {
+				if _, stillFound := found[source]; !stillFound {
+					delete(loaded, source)
+					p.removeModuleBySource(source, backend+" discovery no longer lists the module")
+				}
+			}
+		}
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
 		}
 	}
 }
@@ -135,59 +281,94 @@ func (p *Plugin) loadSwModFromFile(fpath string) (swModule, error) {
 	if err != nil {
 		return swMod, fmt.Errorf("failed to parse PID file %s: %v", fname, err)
 	}
-	swMod, err = p.getCnfModels(pf)
+	swMod, err = p.loadSwMod(pf, fpath)
 	if err != nil {
 		return swMod, fmt.Errorf("failed to obtain CNF models (pid file: %v): %v", fname, err)
 	}
 	return swMod, nil
 }
 
-func (p *Plugin) getCnfModels(pf PidFile) (swMod swModule, err error) {
-	swMod.pid = pf.Pid
-	swMod.ipAddress = pf.IpAddress
-	swMod.grpcPort = pf.GrpcPort
-	swMod.httpPort = pf.HttpPort
+// loadSwMod connects to the SW-Module described by the record found by a discovery backend
+// (pid file or other source).
+func (p *Plugin) loadSwMod(pf PidFile, source string) (swModule, error) {
+	swMod, err := p.getCnfModels(pf)
+	if err != nil {
+		return swMod, err
+	}
+	swMod.source = source
+	return swMod, nil
+}
 
-	// connect to the SW-Module CNF over gRPC
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
-	swMod.grpcConn, err = grpc.DialContext(ctx, fmt.Sprintf("%s:%d", pf.IpAddress, pf.GrpcPort),
-		grpc.WithBlock(), grpc.WithInsecure())
+func (p *Plugin) getCnfModels(pf PidFile) (swMod swModule, err error) {
+	swMod, err = p.dialSwMod(pf)
 	if err != nil {
 		return swMod, err
 	}
 
 	// call DiscoverCnf to learn the names of proto messages exposed by CNF
-	swMod.cnfClient = pb.NewCnfDiscoveryClient(swMod.grpcConn)
 	var swGrpcPort, swHttpPort int
 	swGrpcPort = p.GRPCPlugin.GetPort()
 	if p.HTTPPlugin != nil {
 		swHttpPort = p.HTTPPlugin.GetPort()
 	}
-	ctx = context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.RPCTimeout)
+	defer cancel()
 	resp, err := swMod.cnfClient.DiscoverCnf(ctx, &pb.DiscoverCnfReq{
-		SwIpAddress: p.ipAddress.String(),
-		SwGrpcPort:  uint32(swGrpcPort),
-		SwHttpPort:  uint32(swHttpPort),
+		SwIpAddress:    p.advertisedAddress(),
+		SwGrpcPort:     uint32(swGrpcPort),
+		SwHttpPort:     uint32(swHttpPort),
+		SwCapabilities: p.capabilities(),
 	})
 	if err != nil {
 		return swMod, err
 	}
-	swMod.cnfMsLabel = resp.CnfMsLabel
+	if err = p.loadCnfModels(&swMod, resp); err != nil {
+		_ = swMod.grpcConn.Close()
+		return swMod, err
+	}
+	return swMod, nil
+}
+
+// dialSwMod connects to the SW-Module CNF over gRPC.
+func (p *Plugin) dialSwMod(pf PidFile) (swMod swModule, err error) {
+	swMod.pid = pf.Pid
+	swMod.ipAddress = pf.IpAddress
+	swMod.grpcPort = pf.GrpcPort
+	swMod.httpPort = pf.HttpPort
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	var tlsIdentity string
+	if p.tls != nil {
+		tlsIdentity = p.config.TLS.ModuleIdentities[pf.MsLabel]
+	}
+	swMod.grpcConn, err = grpc.DialContext(ctx, net.JoinHostPort(pf.IpAddress, strconv.Itoa(pf.GrpcPort)),
+		grpc.WithBlock(), p.tls.dialOption(tlsIdentity))
+	if err != nil {
+		return swMod, err
+	}
+	swMod.cnfClient = pb.NewCnfDiscoveryClient(swMod.grpcConn)
+	return swMod, nil
+}
+
+// loadCnfModels finds models exposed by the SW-Module (as described by DiscoverCnf or RegisterCnf)
+// and negotiates how they can be used. Incompatible SW-Module is recorded as rejected.
+func (p *Plugin) loadCnfModels(swMod *swModule, cnf *pb.DiscoverCnfResp) (err error) {
+	swMod.cnfMsLabel = cnf.GetCnfMsLabel()
 
 	// call KnownModels to get meta information about models exposed by CNF
 	swMod.cfgClient, err = remoteclient.NewClientGRPC(swMod.grpcConn,
 		remoteclient.UseRemoteRegistry("config"))
 	if err != nil {
-		return swMod, err
+		return err
 	}
 	models, err := swMod.cfgClient.KnownModels("config")
 	if err != nil {
-		return swMod, err
+		return err
 	}
 
 	// for each exposed proto message find the corresponding model
-	for _, cfgModel := range resp.ConfigModels {
+	for _, cfgModel := range cnf.GetConfigModels() {
 		var found bool
 		for _, model := range models {
 			if model.ProtoName == cfgModel.ProtoName {
@@ -196,6 +377,7 @@ func (p *Plugin) getCnfModels(pf PidFile) (swMod swModule, err error) {
 					withPunt:     cfgModel.WithPunt,
 					withDeps:     cfgModel.WithDeps,
 					withRetrieve: cfgModel.WithRetrieve,
+					withValidate: cfgModel.WithValidate,
 				})
 				found = true
 				break
@@ -205,7 +387,17 @@ func (p *Plugin) getCnfModels(pf PidFile) (swMod swModule, err error) {
 			p.Log.Warnf("failed to find model info for proto message %s", cfgModel.ProtoName)
 		}
 	}
-	return swMod, nil
+	if len(cnf.GetStateModels()) > 0 {
+		if err = p.loadStateModels(swMod, cnf.GetStateModels()); err != nil {
+			return err
+		}
+	}
+	p.loadGrpcServices(swMod, cnf.GetGrpcServices())
+	if err = p.negotiate(swMod, cnf.GetCapabilities()); err != nil {
+		p.sw.rejected.Set(swMod.cnfMsLabel, *swMod)
+		return fmt.Errorf("SW-Module %s is rejected: %w", swMod.cnfMsLabel, err)
+	}
+	return nil
 }
 
 // discoverMyIP tries to discover the StoneWork/CNF (non-local) (management) IP address.
@@ -263,7 +455,7 @@ func (p *Plugin) writePidFile() error {
 	content, err := json.MarshalIndent(PidFile{
 		Pid:       os.Getpid(),
 		MsLabel:   p.ServiceLabel.GetAgentLabel(),
-		IpAddress: p.ipAddress.String(),
+		IpAddress: p.advertisedAddress(),
 		GrpcPort:  p.GetGrpcPort(),
 		HttpPort:  p.GetHttpPort(),
 	}, "", "  ")
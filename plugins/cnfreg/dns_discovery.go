@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// srvResolver resolves DNS SRV records and addresses of their targets (implemented by net.Resolver).
+type srvResolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (cname string, addrs []*net.SRV, err error)
+	LookupHost(ctx context.Context, host string) (addrs []string, err error)
+}
+
+// dnsDiscovery returns lister of SW-Modules published as DNS SRV records (e.g. of a headless K8s service).
+// Every SRV record of the gRPC service is one SW-Module, HTTP port is taken from the SRV record
+// of the HTTP service with the same target (if configured). Addresses of the targets are included
+// in the source identifier, so that the SW-Module restarted under the same name (with a new address)
+// is re-discovered.
+func (p *Plugin) dnsDiscovery(resolver srvResolver, config *DNSDiscoveryConfig) discoveryLister {
+	return func(ctx context.Context) (map[string]PidFile, error) {
+		_, grpcSrvs, err := resolver.LookupSRV(ctx, "", "", config.GrpcService)
+		if err != nil {
+			return nil, fmt.Errorf("failed to lookup SRV records %s: %w", config.GrpcService, err)
+		}
+		httpPorts := make(map[string]int) // key = target
+		if config.HttpService != "" {
+			_, httpSrvs, err := resolver.LookupSRV(ctx, "", "", config.HttpService)
+			if err != nil {
+				// HTTP port is optional
+				p.Log.Warnf("failed to lookup SRV records %s: %v", config.HttpService, err)
+			}
+			for _, srv := range httpSrvs {
+				httpPorts[srv.Target] = int(srv.Port)
+			}
+		}
+		found := make(map[string]PidFile)
+		for _, srv := range grpcSrvs {
+			host := strings.TrimSuffix(srv.Target, ".")
+			source := fmt.Sprintf("dns:%s:%d", host, srv.Port)
+			addrs, err := resolver.LookupHost(ctx, host)
+			if err != nil {
+				// incomplete listing would remove the SW-Module, the whole listing is retried with the next poll
+				return nil, fmt.Errorf("failed to resolve SRV target %s: %w", host, err)
+			}
+			sort.Strings(addrs)
+			source += "@" + strings.Join(addrs, ",")
+			found[source] = PidFile{
+				IpAddress: host,
+				GrpcPort:  int(srv.Port),
+				HttpPort:  httpPorts[srv.Target],
+			}
+		}
+		return found, nil
+	}
+}
@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "go.pantheon.tech/stonework/proto/cnfreg"
+)
+
+// GRPCProxyConfig configures gRPC proxy of StoneWork, which forwards calls of custom gRPC services
+// of SW-Modules (announced by them in DiscoverCnf / RegisterCnf) to the SW-Module serving the service.
+type GRPCProxyConfig struct {
+	// Address on which the proxy serves (e.g. ":9112").
+	Endpoint string `json:"endpoint"`
+	// Certificate and private key in PEM format for TLS of the proxy (plaintext if not configured).
+	CertFile string `json:"cert-file"`
+	KeyFile  string `json:"key-file"`
+}
+
+// RegisterCnfGrpcService announces custom gRPC service of the CNF (served by the CNF on its gRPC server)
+// to StoneWork, which then makes it available through its gRPC proxy.
+// This method should be used by a SW-Module CNF in the Init phase. The service is given by its full name
+// (e.g. "bfd.BFDWatcher").
+func (p *Plugin) RegisterCnfGrpcService(serviceName string) error {
+	switch p.cnfMode {
+	case pb.CnfMode_STONEWORK_MODULE:
+		p.swMod.Lock()
+		defer p.swMod.Unlock()
+		if p.swMod.discovered {
+			return errors.New("CNF has been already discovered by StoneWork")
+		}
+		p.swMod.grpcServices = append(p.swMod.grpcServices, serviceName)
+
+	case pb.CnfMode_STANDALONE:
+		// nothing to do
+		return nil
+	case pb.CnfMode_STONEWORK:
+		panic(fmt.Errorf("method RegisterCnfGrpcService is not available in the CNF mode %v", p.cnfMode))
+	}
+	return nil
+}
+
+// loadGrpcServices records gRPC services announced by the SW-Module. Service already announced
+// by another loaded SW-Module is not proxied to this SW-Module.
+func (p *Plugin) loadGrpcServices(swMod *swModule, services []string) {
+	for _, service := range services {
+		if owner, announced := p.grpcServiceOwner(service); announced && owner.cnfMsLabel != swMod.cnfMsLabel {
+			p.Log.Warnf("gRPC service %s announced by SW-Module %s is already served by SW-Module %s",
+				service, swMod.cnfMsLabel, owner.cnfMsLabel)
+			continue
+		}
+		swMod.grpcServices = append(swMod.grpcServices, service)
+	}
+}
+
+// grpcServiceOwner returns loaded SW-Module which has announced the given gRPC service.
+func (p *Plugin) grpcServiceOwner(service string) (swMod swModule, found bool) {
+	for kv := range p.sw.modules.Iter() {
+		for _, modService := range kv.Val.grpcServices {
+			if modService == service {
+				swMod, found = kv.Val, true
+			}
+		}
+	}
+	return swMod, found
+}
+
+// startGrpcProxy starts gRPC server of the proxy.
+func (p *Plugin) startGrpcProxy() error {
+	config := p.config.GRPCProxy
+	opts := []grpc.ServerOption{
+		grpc.UnknownServiceHandler(p.grpcPassthrough),
+	}
+	if config.CertFile != "" || config.KeyFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(config.CertFile, config.KeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load TLS certificate of gRPC proxy: %w", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	listener, err := net.Listen("tcp", config.Endpoint)
+	if err != nil {
+		return fmt.Errorf("gRPC proxy failed to listen on %s: %w", config.Endpoint, err)
+	}
+	p.sw.grpcProxy = grpc.NewServer(opts...)
+	go func() {
+		if err := p.sw.grpcProxy.Serve(listener); err != nil {
+			p.Log.Errorf("gRPC proxy has stopped: %v", err)
+		}
+	}()
+	p.Log.Infof("gRPC proxy to SW-Modules is listening on %s", config.Endpoint)
+	return nil
+}
+
+// stopGrpcProxy stops gRPC server of the proxy (if running).
+func (p *Plugin) stopGrpcProxy() {
+	if p.sw.grpcProxy != nil {
+		p.sw.grpcProxy.Stop()
+	}
+}
+
+// grpcPassthrough forwards a call of any method (unary or streaming) to the SW-Module which has announced
+// the service of the method. Messages are relayed without knowing their types: they are decoded into
+// an empty message, which keeps all the fields as unknown fields, and re-encoded unchanged.
+func (p *Plugin) grpcPassthrough(_ interface{}, serverStream grpc.ServerStream) error {
+	fullMethod, ok := grpc.MethodFromServerStream(serverStream)
+	if !ok {
+		return status.Error(codes.Internal, "failed to determine the called method")
+	}
+	service, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	swMod, found := p.grpcServiceOwner(service)
+	if !found {
+		return status.Errorf(codes.Unimplemented, "unknown service %s", service)
+	}
+
+	ctx, cancel := context.WithCancel(serverStream.Context())
+	defer cancel()
+	md, _ := metadata.FromIncomingContext(ctx)
+	clientCtx := metadata.NewOutgoingContext(ctx, md.Copy())
+	clientStream, err := swMod.grpcConn.NewStream(clientCtx,
+		&grpc.StreamDesc{ServerStreams: true, ClientStreams: true}, fullMethod)
+	if err != nil {
+		return err
+	}
+
+	// client -> SW-Module
+	toModule := make(chan error, 1)
+	go func() {
+		for {
+			msg := &emptypb.Empty{}
+			if err := serverStream.RecvMsg(msg); err != nil {
+				toModule <- err
+				return
+			}
+			if err := clientStream.SendMsg(msg); err != nil {
+				toModule <- err
+				return
+			}
+		}
+	}()
+	// SW-Module -> client
+	fromModule := make(chan error, 1)
+	go func() {
+		// headers are forwarded before any message, so that they are kept also with trailers-only response
+		// (if the stream fails before the headers are received, the status is returned by RecvMsg)
+		if header, err := clientStream.Header(); err == nil && len(header) > 0 {
+			if err := serverStream.SendHeader(header); err != nil {
+				fromModule <- err
+				return
+			}
+		}
+		for {
+			msg := &emptypb.Empty{}
+			if err := clientStream.RecvMsg(msg); err != nil {
+				fromModule <- err
+				return
+			}
+			if err := serverStream.SendMsg(msg); err != nil {
+				fromModule <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case err := <-toModule:
+			if !errors.Is(err, io.EOF) {
+				return status.Errorf(codes.Internal, "failed to forward request to SW-Module %s: %v",
+					swMod.cnfMsLabel, err)
+			}
+			// client has finished sending, wait for the SW-Module
+			_ = clientStream.CloseSend()
+			toModule = nil
+		case err := <-fromModule:
+			serverStream.SetTrailer(clientStream.Trailer())
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			// status returned by the SW-Module (or error of the connection)
+			return err
+		}
+	}
+}
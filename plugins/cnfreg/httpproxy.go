@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strconv"
+	"strings"
+
+	"github.com/unrolled/render"
+	"go.ligato.io/cn-infra/v2/rpc/rest"
+)
+
+// REST endpoints of SW-Modules are available on the HTTP server of StoneWork under this prefix + microservice label
+const cnfHTTPProxyPrefix = "/cnf/"
+
+// HTTPProxyConfig configures reverse proxy of StoneWork to HTTP servers of SW-Modules.
+// TLS termination and authentication of clients is done by the HTTP server of StoneWork (see http.conf).
+type HTTPProxyConfig struct {
+	// Connect to HTTP servers of SW-Modules over TLS, using the certificates configured for mutual TLS
+	// between StoneWork and SW-Modules (requires tls to be configured).
+	ModuleTLS bool `json:"module-tls"`
+	// Forward the Authorization header to SW-Modules. By default, the header is removed, clients are authenticated
+	// by StoneWork.
+	ForwardAuthorization bool `json:"forward-authorization"`
+}
+
+// registerHTTPProxy registers handler routing requests for /cnf/<ms-label>/... to the HTTP server of the SW-Module.
+func (p *Plugin) registerHTTPProxy(handlers rest.HTTPHandlers) error {
+	config := p.config.HTTPProxy
+	if config.ModuleTLS && p.tls == nil {
+		return errors.New("HTTP proxy with module-tls requires tls to be configured")
+	}
+	p.sw.httpTransport = http.DefaultTransport.(*http.Transport).Clone()
+	p.sw.httpTransport.ResponseHeaderTimeout = p.config.RPCTimeout
+	handlers.RegisterHTTPHandler(cnfHTTPProxyPrefix+"{label}/{path:.*}", p.httpProxyHandler,
+		http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodOptions)
+	return nil
+}
+
+func (p *Plugin) httpProxyHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		cnfMsLabel, path, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, cnfHTTPProxyPrefix), "/")
+		swMod, loaded := p.sw.modules.Get(cnfMsLabel)
+		if !loaded {
+			http.Error(w, fmt.Sprintf("%v: %s", ErrCnfNotLoaded, cnfMsLabel), http.StatusNotFound)
+			return
+		}
+		if swMod.httpPort == 0 {
+			http.Error(w, fmt.Sprintf("SW-Module %s does not serve HTTP", cnfMsLabel), http.StatusNotFound)
+			return
+		}
+		host := net.JoinHostPort(swMod.ipAddress, strconv.Itoa(swMod.httpPort))
+		proxy := &httputil.ReverseProxy{
+			Director: func(out *http.Request) {
+				out.URL.Scheme = "http"
+				out.URL.Host = host
+				out.URL.Path = "/" + path
+				out.URL.RawPath = ""
+				out.Host = host
+				out.Header.Set("X-Forwarded-Prefix", cnfHTTPProxyPrefix+cnfMsLabel)
+				if !p.config.HTTPProxy.ForwardAuthorization {
+					out.Header.Del("Authorization")
+				}
+				if p.config.HTTPProxy.ModuleTLS {
+					out.URL.Scheme = "https"
+				}
+			},
+			Transport: p.moduleHTTPTransport(cnfMsLabel),
+			ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+				p.Log.Warnf("failed to proxy %s %s to SW-Module %s: %v", req.Method, req.URL.Path, cnfMsLabel, err)
+				http.Error(w, fmt.Sprintf("SW-Module %s is not reachable: %v", cnfMsLabel, err),
+					http.StatusBadGateway)
+			},
+		}
+		proxy.ServeHTTP(w, req)
+	}
+}
+
+// moduleHTTPTransport returns HTTP transport used for requests proxied to the given SW-Module.
+// With TLS, every SW-Module has its own transport verifying the identity of the SW-Module.
+func (p *Plugin) moduleHTTPTransport(cnfMsLabel string) http.RoundTripper {
+	if !p.config.HTTPProxy.ModuleTLS {
+		return p.sw.httpTransport
+	}
+	if transport, exists := p.sw.httpTLSTransports.Get(cnfMsLabel); exists {
+		return transport
+	}
+	transport := p.sw.httpTransport.Clone()
+	transport.TLSClientConfig = p.tls.clientConfig(p.config.TLS.ModuleIdentities[cnfMsLabel])
+	p.sw.httpTLSTransports.Set(cnfMsLabel, transport)
+	return transport
+}
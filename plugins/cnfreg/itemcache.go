@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	"google.golang.org/protobuf/proto"
+
+	pb "go.pantheon.tech/stonework/proto/cnfreg"
+)
+
+// maximum number of items asked about in a single GetItemsInfo request
+const itemsInfoBatchSize = 256
+
+// itemInfo caches punt requests and dependencies obtained from the SW-Module for a single value.
+// Only the info of the last seen value is cached for every key.
+type itemInfo struct {
+	valueHash [sha256.Size]byte
+	puntReqs  puntReqsForKey // nil if not obtained yet
+	deps      []*pb.ConfigItemDependency
+	withDeps  bool // deps were obtained (may be empty)
+}
+
+// hashValue returns hash of the deterministically marshalled value.
+func hashValue(value proto.Message) (hash [sha256.Size]byte, err error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(value)
+	if err != nil {
+		return hash, err
+	}
+	return sha256.Sum256(data), nil
+}
+
+// cachedInfo returns copy of the info cached for the key, nil if nothing is cached for the value with the given hash.
+func (m *moduleProxy) cachedInfo(key string, valueHash [sha256.Size]byte) *itemInfo {
+	m.Lock()
+	defer m.Unlock()
+	info := m.cache[key]
+	if info == nil || info.valueHash != valueHash {
+		return nil
+	}
+	infoCopy := *info
+	return &infoCopy
+}
+
+// cacheGeneration returns the current generation of the cache. Info obtained from the SW-Module
+// is cached only if the SW-Module has not reconnected in the meantime.
+func (m *moduleProxy) cacheGeneration() uint64 {
+	m.Lock()
+	defer m.Unlock()
+	return m.generation
+}
+
+// updateCache updates info cached for the key (info of a different value is replaced).
+func (m *moduleProxy) updateCache(key string, valueHash [sha256.Size]byte, generation uint64,
+	update func(info *itemInfo)) {
+	m.Lock()
+	defer m.Unlock()
+	if generation != m.generation {
+		return
+	}
+	info := m.cache[key]
+	if info == nil || info.valueHash != valueHash {
+		info = &itemInfo{valueHash: valueHash}
+		m.cache[key] = info
+	}
+	update(info)
+}
+
+// uncache removes info cached for the key.
+func (m *moduleProxy) uncache(key string) {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.cache, key)
+}
+
+// withItemsInfo returns true if the SW-Module serves the batched GetItemsInfo.
+func (m *moduleProxy) withItemsInfo() bool {
+	m.Lock()
+	defer m.Unlock()
+	return m.compat != nil && m.compat.withItemsInfo
+}
+
+// prefetch obtains punt requests and dependencies of the given values from the SW-Module using
+// the batched GetItemsInfo and caches them. Values with already cached info are skipped.
+// Failures are only logged, info which was not prefetched is obtained for each value separately.
+func (p *proxyDescriptor) prefetch(kvPairs []kvs.KVWithMetadata) {
+	if !p.withPunt && !p.withDeps {
+		return
+	}
+	cnfClient, _, available := p.module.clients()
+	if !available || !p.module.withItemsInfo() {
+		return
+	}
+	generation := p.module.cacheGeneration()
+
+	var (
+		keys   []string
+		hashes [][sha256.Size]byte
+		items  []*generic.Item
+	)
+	flush := func() {
+		if len(items) == 0 {
+			return
+		}
+		req := &pb.GetItemsInfoReq{
+			Items:            items,
+			WithPuntRequests: p.withPunt,
+			WithDependencies: p.withDeps,
+		}
+		var resp *pb.GetItemsInfoResp
+		err := p.module.call("GetItemsInfo", func(ctx context.Context) (err error) {
+			resp, err = cnfClient.GetItemsInfo(ctx, req)
+			return err
+		})
+		if err != nil {
+			p.log.Warnf("GetItemsInfo failed for %d items: %v", len(items), err)
+		} else {
+			p.cacheItemsInfo(keys, hashes, resp.GetItems(), generation)
+		}
+		keys, hashes, items = nil, nil, nil
+	}
+	for _, kv := range kvPairs {
+		valueHash, err := hashValue(kv.Value)
+		if err != nil {
+			continue
+		}
+		if info := p.module.cachedInfo(kv.Key, valueHash); info != nil &&
+			(!p.withPunt || info.puntReqs != nil) && (!p.withDeps || info.withDeps) {
+			continue
+		}
+		item, err := models.MarshalItem(kv.Value)
+		if err != nil {
+			continue
+		}
+		keys = append(keys, kv.Key)
+		hashes = append(hashes, valueHash)
+		items = append(items, item)
+		if len(items) == itemsInfoBatchSize {
+			flush()
+		}
+	}
+	flush()
+}
+
+// cacheItemsInfo caches info returned by GetItemsInfo (in the order of the request).
+func (p *proxyDescriptor) cacheItemsInfo(keys []string, hashes [][sha256.Size]byte,
+	infos []*pb.GetItemsInfoResp_ItemInfo, generation uint64) {
+	if len(infos) != len(keys) {
+		p.log.Warnf("GetItemsInfo returned info for %d items instead of %d", len(infos), len(keys))
+		return
+	}
+	for i, info := range infos {
+		if info.GetError() != "" {
+			p.log.Debugf("GetItemsInfo failed for %s: %s", keys[i], info.GetError())
+			continue
+		}
+		var puntReqs puntReqsForKey
+		if p.withPunt {
+			puntReqs = make(puntReqsForKey)
+			for _, puntReq := range info.GetPuntRequests().GetPuntRequests() {
+				if err := p.module.checkPunt(puntReq); err != nil {
+					// not cached, the error is reported when the punt requests are needed
+					p.log.Debug(fmt.Errorf("punt %s requested for %s cannot be used: %w",
+						puntReq.Label, keys[i], err))
+					puntReqs = nil
+					break
+				}
+				puntReqs[puntReq.Label] = puntReq
+			}
+		}
+		p.module.updateCache(keys[i], hashes[i], generation, func(cached *itemInfo) {
+			if puntReqs != nil {
+				cached.puntReqs = puntReqs
+			}
+			if p.withDeps {
+				cached.deps = info.GetDependencies()
+				cached.withDeps = true
+			}
+		})
+	}
+}
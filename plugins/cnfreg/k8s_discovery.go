@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// Annotations of SW-Module pods used by the Kubernetes discovery.
+	K8sAnnotationGrpcPort = "stonework.pantheon.tech/grpc-port" // required
+	K8sAnnotationHttpPort = "stonework.pantheon.tech/http-port"
+	K8sAnnotationMsLabel  = "stonework.pantheon.tech/ms-label"
+	K8sAnnotationAddress  = "stonework.pantheon.tech/address" // hostname to use instead of the pod IP
+
+	k8sNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+	// Maximum number of pods returned by the Kubernetes API in a single response.
+	k8sPodListLimit = 500
+)
+
+// newK8sClient returns client of the Kubernetes API using the service account of the pod,
+// together with the namespace of the pod.
+func newK8sClient() (client kubernetes.Interface, namespace string, err error) {
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, "", err
+	}
+	client, err = kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, "", err
+	}
+	ns, err := os.ReadFile(k8sNamespaceFile)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, strings.TrimSpace(string(ns)), nil
+}
+
+// k8sDiscovery returns lister of SW-Modules running as Kubernetes pods selected by the label selector.
+// Pods are listed from the configured namespace, or from the namespace of StoneWork if not configured.
+// Only running pods with the gRPC port annotation are considered. Container restarts are reflected
+// in the source identifier, so that the restarted SW-Module is re-discovered.
+func (p *Plugin) k8sDiscovery(client kubernetes.Interface, namespace string,
+	config *K8sDiscoveryConfig) discoveryLister {
+	if config.Namespace != "" {
+		namespace = config.Namespace
+	}
+	return func(ctx context.Context) (map[string]PidFile, error) {
+		pods, err := listK8sPods(ctx, client, namespace, config.LabelSelector)
+		if err != nil {
+			return nil, err
+		}
+		found := make(map[string]PidFile)
+		for _, pod := range pods {
+			grpcPortAnnot, isSwModule := pod.Annotations[K8sAnnotationGrpcPort]
+			if !isSwModule || pod.Status.Phase != corev1.PodRunning {
+				continue
+			}
+			pf := PidFile{
+				MsLabel:   pod.Annotations[K8sAnnotationMsLabel],
+				IpAddress: pod.Status.PodIP,
+			}
+			if address := pod.Annotations[K8sAnnotationAddress]; address != "" {
+				pf.IpAddress = address
+			}
+			if pf.IpAddress == "" {
+				continue
+			}
+			if pf.GrpcPort, err = strconv.Atoi(grpcPortAnnot); err != nil {
+				p.Log.Warnf("pod %s/%s has invalid %s annotation: %v",
+					pod.Namespace, pod.Name, K8sAnnotationGrpcPort, err)
+				continue
+			}
+			if httpPortAnnot := pod.Annotations[K8sAnnotationHttpPort]; httpPortAnnot != "" {
+				if pf.HttpPort, err = strconv.Atoi(httpPortAnnot); err != nil {
+					p.Log.Warnf("pod %s/%s has invalid %s annotation: %v",
+						pod.Namespace, pod.Name, K8sAnnotationHttpPort, err)
+				}
+			}
+			var restarts int32
+			for _, container := range pod.Status.ContainerStatuses {
+				restarts += container.RestartCount
+			}
+			source := fmt.Sprintf("k8s:%s/%s#%d", pod.Namespace, pod.Name, restarts)
+			found[source] = pf
+		}
+		return found, nil
+	}
+}
+
+// listK8sPods lists pods page by page (following the continue token), so that large namespaces are not truncated.
+func listK8sPods(ctx context.Context, client kubernetes.Interface, namespace,
+	labelSelector string) (pods []corev1.Pod, err error) {
+	opts := metav1.ListOptions{
+		LabelSelector: labelSelector,
+		Limit:         k8sPodListLimit,
+	}
+	for {
+		podList, err := client.CoreV1().Pods(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, podList.Items...)
+		opts.Continue = podList.Continue
+		if opts.Continue == "" {
+			return pods, nil
+		}
+	}
+}
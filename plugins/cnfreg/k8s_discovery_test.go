@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func testPod(namespace, name string, phase corev1.PodPhase, podIP string, labels, annotations map[string]string,
+	restarts ...int32) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			PodIP: podIP,
+		},
+	}
+	for _, restartCount := range restarts {
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses,
+			corev1.ContainerStatus{RestartCount: restartCount})
+	}
+	return pod
+}
+
+func testDiscoveryPlugin() *Plugin {
+	p := &Plugin{}
+	p.Log = logging.ForPlugin("cnfreg-test")
+	return p
+}
+
+func TestK8sDiscovery(t *testing.T) {
+	RegisterTestingT(t)
+	swModLabels := map[string]string{"app": "sw-module"}
+	client := fake.NewSimpleClientset(
+		testPod("stonework", "bgp", corev1.PodRunning, "10.0.0.1", swModLabels,
+			map[string]string{
+				K8sAnnotationGrpcPort: "9111",
+				K8sAnnotationHttpPort: "9191",
+				K8sAnnotationMsLabel:  "bgp",
+			}, 1, 2),
+		testPod("stonework", "ospf", corev1.PodRunning, "10.0.0.2", swModLabels,
+			map[string]string{
+				K8sAnnotationGrpcPort: "9112",
+				K8sAnnotationAddress:  "ospf.sw-modules.stonework.svc",
+			}),
+		// not a SW-Module (missing gRPC port annotation)
+		testPod("stonework", "sidecar", corev1.PodRunning, "10.0.0.3", swModLabels, nil),
+		// not running
+		testPod("stonework", "pending", corev1.PodPending, "10.0.0.4", swModLabels,
+			map[string]string{K8sAnnotationGrpcPort: "9113"}),
+		// without address
+		testPod("stonework", "no-ip", corev1.PodRunning, "", swModLabels,
+			map[string]string{K8sAnnotationGrpcPort: "9114"}),
+		// invalid gRPC port
+		testPod("stonework", "invalid", corev1.PodRunning, "10.0.0.5", swModLabels,
+			map[string]string{K8sAnnotationGrpcPort: "grpc"}),
+		// not selected by labels
+		testPod("stonework", "other", corev1.PodRunning, "10.0.0.6", map[string]string{"app": "other"},
+			map[string]string{K8sAnnotationGrpcPort: "9115"}),
+		// other namespace
+		testPod("default", "remote", corev1.PodRunning, "10.0.0.7", swModLabels,
+			map[string]string{K8sAnnotationGrpcPort: "9116"}),
+	)
+	p := testDiscoveryPlugin()
+	list := p.k8sDiscovery(client, "stonework", &K8sDiscoveryConfig{LabelSelector: "app=sw-module"})
+
+	found, err := list(context.Background())
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(Equal(map[string]PidFile{
+		"k8s:stonework/bgp#3": {
+			MsLabel:   "bgp",
+			IpAddress: "10.0.0.1",
+			GrpcPort:  9111,
+			HttpPort:  9191,
+		},
+		"k8s:stonework/ospf#0": {
+			IpAddress: "ospf.sw-modules.stonework.svc",
+			GrpcPort:  9112,
+		},
+	}))
+
+	// restarted container is listed under a new source
+	pods := client.CoreV1().Pods("stonework")
+	ospf, err := pods.Get(context.Background(), "ospf", metav1.GetOptions{})
+	Expect(err).ToNot(HaveOccurred())
+	ospf.Status.ContainerStatuses = append(ospf.Status.ContainerStatuses, corev1.ContainerStatus{RestartCount: 1})
+	_, err = pods.UpdateStatus(context.Background(), ospf, metav1.UpdateOptions{})
+	Expect(err).ToNot(HaveOccurred())
+	found, err = list(context.Background())
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(HaveKey("k8s:stonework/ospf#1"))
+	Expect(found).ToNot(HaveKey("k8s:stonework/ospf#0"))
+
+	// configured namespace, all pods
+	list = p.k8sDiscovery(client, "stonework", &K8sDiscoveryConfig{Namespace: "default"})
+	found, err = list(context.Background())
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(HaveLen(1))
+	Expect(found).To(HaveKey("k8s:default/remote#0"))
+}
+
+func TestK8sDiscoveryPagination(t *testing.T) {
+	RegisterTestingT(t)
+	const pages = 3
+	client := fake.NewSimpleClientset()
+	// the fake clientset does not paginate, pages are served by the reactor
+	// (continue token of the request is not visible to the reactor, pages are therefore counted)
+	var page int
+	client.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		listAction := action.(k8stesting.ListActionImpl)
+		if listAction.GetNamespace() != "stonework" {
+			return true, nil, errors.New("forbidden")
+		}
+		Expect(listAction.GetListRestrictions().Labels.String()).To(Equal("app=sw-module"))
+		podList := &corev1.PodList{}
+		pod := testPod("stonework", fmt.Sprintf("pod-%d", page), corev1.PodRunning, "10.0.0.1",
+			map[string]string{"app": "sw-module"}, map[string]string{K8sAnnotationGrpcPort: "9111"})
+		podList.Items = append(podList.Items, *pod)
+		if page++; page < pages {
+			podList.Continue = fmt.Sprintf("page-%d", page)
+		}
+		return true, podList, nil
+	})
+	p := testDiscoveryPlugin()
+
+	list := p.k8sDiscovery(client, "stonework", &K8sDiscoveryConfig{LabelSelector: "app=sw-module"})
+	found, err := list(context.Background())
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(HaveLen(pages))
+	for i := 0; i < pages; i++ {
+		Expect(found).To(HaveKey(fmt.Sprintf("k8s:stonework/pod-%d#0", i)))
+	}
+
+	// failed listing fails the whole discovery
+	list = p.k8sDiscovery(client, "stonework", &K8sDiscoveryConfig{Namespace: "default",
+		LabelSelector: "app=sw-module"})
+	_, err = list(context.Background())
+	Expect(err).To(HaveOccurred())
+}
+
+// fakeSrvResolver serves SRV records and host addresses from memory.
+type fakeSrvResolver struct {
+	srvs  map[string][]*net.SRV // key = name
+	hosts map[string][]string
+}
+
+func (r *fakeSrvResolver) LookupSRV(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+	srvs, ok := r.srvs[name]
+	if !ok {
+		return "", nil, errors.New("no such host")
+	}
+	return name, srvs, nil
+}
+
+func (r *fakeSrvResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	addrs, ok := r.hosts[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addrs, nil
+}
+
+func TestDNSDiscovery(t *testing.T) {
+	RegisterTestingT(t)
+	const (
+		grpcService = "_grpc._tcp.sw-modules.stonework.svc.cluster.local"
+		httpService = "_http._tcp.sw-modules.stonework.svc.cluster.local"
+	)
+	resolver := &fakeSrvResolver{
+		srvs: map[string][]*net.SRV{
+			grpcService: {
+				{Target: "bgp.sw-modules.stonework.svc.cluster.local.", Port: 9111},
+				{Target: "ospf.sw-modules.stonework.svc.cluster.local.", Port: 9112},
+			},
+			httpService: {
+				{Target: "bgp.sw-modules.stonework.svc.cluster.local.", Port: 9191},
+			},
+		},
+		hosts: map[string][]string{
+			"bgp.sw-modules.stonework.svc.cluster.local":  {"10.0.0.1"},
+			"ospf.sw-modules.stonework.svc.cluster.local": {"10.0.0.2"},
+		},
+	}
+	p := testDiscoveryPlugin()
+	list := p.dnsDiscovery(resolver, &DNSDiscoveryConfig{GrpcService: grpcService, HttpService: httpService})
+
+	found, err := list(context.Background())
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(Equal(map[string]PidFile{
+		"dns:bgp.sw-modules.stonework.svc.cluster.local:9111@10.0.0.1": {
+			IpAddress: "bgp.sw-modules.stonework.svc.cluster.local",
+			GrpcPort:  9111,
+			HttpPort:  9191,
+		},
+		"dns:ospf.sw-modules.stonework.svc.cluster.local:9112@10.0.0.2": {
+			IpAddress: "ospf.sw-modules.stonework.svc.cluster.local",
+			GrpcPort:  9112,
+		},
+	}))
+
+	// restarted SW-Module re-appears under the same name with a new address
+	resolver.hosts["ospf.sw-modules.stonework.svc.cluster.local"] = []string{"10.0.0.3"}
+	found, err = list(context.Background())
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(HaveKey("dns:ospf.sw-modules.stonework.svc.cluster.local:9112@10.0.0.3"))
+	Expect(found).ToNot(HaveKey("dns:ospf.sw-modules.stonework.svc.cluster.local:9112@10.0.0.2"))
+
+	// failed resolution fails the whole listing (SW-Modules are not removed)
+	delete(resolver.hosts, "ospf.sw-modules.stonework.svc.cluster.local")
+	_, err = list(context.Background())
+	Expect(err).To(HaveOccurred())
+
+	// missing HTTP records are tolerated
+	resolver.hosts["ospf.sw-modules.stonework.svc.cluster.local"] = []string{"10.0.0.3"}
+	delete(resolver.srvs, httpService)
+	found, err = list(context.Background())
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(HaveLen(2))
+}
@@ -0,0 +1,361 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "go.pantheon.tech/stonework/proto/cnfreg"
+)
+
+const (
+	// SW-Module without access to the CnfRegistry service requests lease by writing <ms-label>.lease-req
+	// next to pid files, StoneWork responds with <ms-label>.lease
+	leaseReqFileExt = ".lease-req"
+	leaseFileExt    = ".lease"
+
+	// how often SW-Module checks for the lease file written by StoneWork
+	leasePollPeriod = 100 * time.Millisecond
+)
+
+// ErrCnfIndexesExhausted is returned when all CNF indexes (for which the port ranges of SW-Modules
+// do not overlap) are already leased.
+var ErrCnfIndexesExhausted = errors.New("all CNF indexes are leased")
+
+// CnfLease is CNF index (and ports derived from it) leased by StoneWork to SW-Module.
+// It is also the content of the lease file written by StoneWork.
+type CnfLease struct {
+	MsLabel  string `json:"ms-label"`
+	CnfIndex int    `json:"cnf-index"`
+	GrpcPort int    `json:"grpc-port"`
+	HttpPort int    `json:"http-port"`
+}
+
+// leaseRequest is the content of the lease request file written by SW-Module.
+type leaseRequest struct {
+	MsLabel string `json:"ms-label"`
+	Pid     int    `json:"pid"`
+}
+
+// cnfLeases are CNF indexes leased by StoneWork, persisted in a file.
+type cnfLeases struct {
+	sync.Mutex
+	file     string
+	leases   map[string]int       // key = cnf microservice label, value = CNF index
+	lastSeen map[string]time.Time // key = cnf microservice label, when was the lease last known to be used
+}
+
+// loadCnfLeases loads CNF indexes leased before restart.
+// Expiration of the loaded leases starts from now.
+func loadCnfLeases(file string) (*cnfLeases, error) {
+	l := &cnfLeases{
+		file:     file,
+		leases:   make(map[string]int),
+		lastSeen: make(map[string]time.Time),
+	}
+	if file == "" {
+		return l, nil
+	}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return l, nil
+		}
+		return nil, fmt.Errorf("failed to read CNF leases %s: %w", file, err)
+	}
+	if err = json.Unmarshal(content, &l.leases); err != nil {
+		return nil, fmt.Errorf("failed to parse CNF leases %s: %w", file, err)
+	}
+	now := time.Now()
+	for cnfMsLabel := range l.leases {
+		l.lastSeen[cnfMsLabel] = now
+	}
+	return l, nil
+}
+
+// save writes leases into the file.
+// The method should be called with the leases locked.
+func (l *cnfLeases) save() error {
+	if l.file == "" {
+		return nil
+	}
+	content, err := json.MarshalIndent(l.leases, "", "  ")
+	if err != nil {
+		return err
+	}
+	// write atomically to never leave a partially written file behind
+	_ = os.MkdirAll(filepath.Dir(l.file), 0755)
+	tmpFile := l.file + ".tmp"
+	if err = os.WriteFile(tmpFile, content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, l.file)
+}
+
+// leaseCnfIndex returns CNF index leased to the SW-Module with the given microservice label.
+// A new lease gets the lowest index which is neither leased nor used (statically) by a loaded SW-Module.
+func (p *Plugin) leaseCnfIndex(cnfMsLabel string) (lease CnfLease, err error) {
+	l := p.sw.leases
+	l.Lock()
+	defer l.Unlock()
+	index, leased := l.leases[cnfMsLabel]
+	if !leased {
+		used := make(map[int]struct{})
+		for _, idx := range l.leases {
+			used[idx] = struct{}{}
+		}
+		for kv := range p.sw.modules.Iter() {
+			used[kv.Val.grpcPort-p.config.SwModGrpcBasePort] = struct{}{}
+		}
+		for idx := 1; idx <= p.maxCnfIndex(); idx++ {
+			if _, isUsed := used[idx]; !isUsed {
+				index = idx
+				break
+			}
+		}
+		if index == 0 {
+			return lease, fmt.Errorf("%w (%d)", ErrCnfIndexesExhausted, p.maxCnfIndex())
+		}
+		l.leases[cnfMsLabel] = index
+		if err = l.save(); err != nil {
+			delete(l.leases, cnfMsLabel)
+			return lease, fmt.Errorf("failed to persist CNF lease: %w", err)
+		}
+		p.Log.Infof("Leased CNF index %d to SW-Module %s", index, cnfMsLabel)
+	}
+	l.lastSeen[cnfMsLabel] = time.Now()
+	return CnfLease{
+		MsLabel:  cnfMsLabel,
+		CnfIndex: index,
+		GrpcPort: p.config.SwModGrpcBasePort + index,
+		HttpPort: p.config.SwModHttpBasePort + index,
+	}, nil
+}
+
+// expireCnfLeases releases leases of SW-Modules which have not been loaded for longer than LeaseTTL.
+// Leases of loaded SW-Modules are renewed.
+func (p *Plugin) expireCnfLeases(now time.Time) {
+	l := p.sw.leases
+	l.Lock()
+	defer l.Unlock()
+	var expired bool
+	for cnfMsLabel, index := range l.leases {
+		if _, loaded := p.sw.modules.Get(cnfMsLabel); loaded {
+			l.lastSeen[cnfMsLabel] = now
+			continue
+		}
+		if now.Sub(l.lastSeen[cnfMsLabel]) < p.config.LeaseTTL {
+			continue
+		}
+		p.Log.Infof("Lease of CNF index %d to SW-Module %s has expired", index, cnfMsLabel)
+		delete(l.leases, cnfMsLabel)
+		delete(l.lastSeen, cnfMsLabel)
+		expired = true
+	}
+	if expired {
+		if err := l.save(); err != nil {
+			p.Log.Errorf("failed to persist CNF leases: %v", err)
+		}
+	}
+}
+
+// maxCnfIndex returns the highest CNF index for which the gRPC and HTTP port ranges of SW-Modules do not overlap.
+func (p *Plugin) maxCnfIndex() int {
+	gap := p.config.SwModHttpBasePort - p.config.SwModGrpcBasePort
+	if gap < 0 {
+		gap = -gap
+	}
+	return gap - 1
+}
+
+// LeaseCnfIndex is served by the CNFRegistry of StoneWork and leases CNF index to the calling SW-Module.
+func (p *Plugin) LeaseCnfIndex(ctx context.Context, req *pb.LeaseCnfIndexReq) (*pb.LeaseCnfIndexResp, error) {
+	p.Log.Debugf("Handling LeaseCnfIndex(%+v)", req)
+	if req.GetCnfMsLabel() == "" {
+		return nil, status.Error(codes.InvalidArgument, "lease requires microservice label")
+	}
+	if err := p.verifyModulePeer(ctx, req.GetCnfMsLabel()); err != nil {
+		return nil, err
+	}
+	lease, err := p.leaseCnfIndex(req.GetCnfMsLabel())
+	if err != nil {
+		if errors.Is(err, ErrCnfIndexesExhausted) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.LeaseCnfIndexResp{
+		CnfIndex: uint32(lease.CnfIndex),
+		GrpcPort: uint32(lease.GrpcPort),
+		HttpPort: uint32(lease.HttpPort),
+	}, nil
+}
+
+// handleLeaseRequest responds to the lease request file written by SW-Module with the lease file.
+// Request which is not completely written yet is ignored (it is handled again once written).
+func (p *Plugin) handleLeaseRequest(reqPath string) {
+	content, err := os.ReadFile(reqPath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			p.Log.Errorf("failed to read lease request %s: %v", reqPath, err)
+		}
+		return
+	}
+	var req leaseRequest
+	if err = json.Unmarshal(content, &req); err != nil || req.MsLabel == "" {
+		p.Log.Debugf("lease request %s is not complete yet", reqPath)
+		return
+	}
+	if err = checkLeaseRequestLabel(reqPath, req.MsLabel); err != nil {
+		p.Log.Errorf("rejected lease request %s: %v", reqPath, err)
+		return
+	}
+	lease, err := p.leaseCnfIndex(req.MsLabel)
+	if err != nil {
+		p.Log.Errorf("failed to lease CNF index to SW-Module %s: %v", req.MsLabel, err)
+		return
+	}
+	content, err = json.MarshalIndent(lease, "", "  ")
+	if err != nil {
+		p.Log.Errorf("failed to marshal CNF lease: %v", err)
+		return
+	}
+	leasePath := path.Join(filepath.Dir(reqPath), req.MsLabel+leaseFileExt)
+	tmpFile := leasePath + ".tmp"
+	if err = os.WriteFile(tmpFile, content, 0644); err == nil {
+		err = os.Rename(tmpFile, leasePath)
+	}
+	if err != nil {
+		p.Log.Errorf("failed to write lease file %s: %v", leasePath, err)
+		return
+	}
+	_ = os.Remove(reqPath)
+}
+
+// checkLeaseRequestLabel checks that the microservice label from the lease request matches the name
+// of the request file. The label names the lease file, which therefore cannot be written outside
+// of the discovery directory.
+func checkLeaseRequestLabel(reqPath, msLabel string) error {
+	if strings.ContainsAny(msLabel, `/\`) || strings.Contains(msLabel, "..") {
+		return fmt.Errorf("invalid microservice label %q", msLabel)
+	}
+	if reqLabel := strings.TrimSuffix(filepath.Base(reqPath), leaseReqFileExt); reqLabel != msLabel {
+		return fmt.Errorf("microservice label %q does not match the request file", msLabel)
+	}
+	return nil
+}
+
+// obtainCnfLease is used by SW-Module during Init to lease CNF index from StoneWork, through the CnfRegistry
+// service if the address of StoneWork is configured, otherwise through the lease request file written
+// next to pid files. Static CnfIndex is used if StoneWork does not respond within the lease timeout.
+func (p *Plugin) obtainCnfLease() error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.LeaseTimeout)
+	defer cancel()
+	var (
+		lease CnfLease
+		err   error
+	)
+	if p.config.StoneWorkAddress != "" {
+		lease, err = p.leaseOverGrpc(ctx)
+	} else {
+		lease, err = p.leaseOverFile(ctx)
+	}
+	if err != nil {
+		if p.CnfIndex == 0 {
+			return fmt.Errorf("failed to lease CNF index from StoneWork and CnfIndex is not defined: %w", err)
+		}
+		p.Log.Warnf("failed to lease CNF index from StoneWork, using static CnfIndex %d: %v", p.CnfIndex, err)
+		return nil
+	}
+	p.Log.Infof("Leased CNF index %d from StoneWork (gRPC port %d, HTTP port %d)",
+		lease.CnfIndex, lease.GrpcPort, lease.HttpPort)
+	p.swMod.lease = &lease
+	return nil
+}
+
+func (p *Plugin) leaseOverGrpc(ctx context.Context) (lease CnfLease, err error) {
+	var tlsIdentity string
+	if p.tls != nil {
+		tlsIdentity = p.config.TLS.StoneWorkIdentity
+	}
+	conn, err := grpc.DialContext(ctx, p.config.StoneWorkAddress, grpc.WithBlock(), p.tls.dialOption(tlsIdentity))
+	if err != nil {
+		return lease, err
+	}
+	defer func() { _ = conn.Close() }()
+	resp, err := pb.NewCnfRegistryClient(conn).LeaseCnfIndex(ctx, &pb.LeaseCnfIndexReq{
+		CnfMsLabel: p.ServiceLabel.GetAgentLabel(),
+	})
+	if err != nil {
+		return lease, err
+	}
+	return CnfLease{
+		MsLabel:  p.ServiceLabel.GetAgentLabel(),
+		CnfIndex: int(resp.GetCnfIndex()),
+		GrpcPort: int(resp.GetGrpcPort()),
+		HttpPort: int(resp.GetHttpPort()),
+	}, nil
+}
+
+func (p *Plugin) leaseOverFile(ctx context.Context) (lease CnfLease, err error) {
+	msLabel := p.ServiceLabel.GetAgentLabel()
+	reqPath := path.Join(pidFileDir, msLabel+leaseReqFileExt)
+	leasePath := path.Join(pidFileDir, msLabel+leaseFileExt)
+	_ = os.Remove(leasePath) // left behind by the previous run
+	content, err := json.Marshal(leaseRequest{
+		MsLabel: msLabel,
+		Pid:     os.Getpid(),
+	})
+	if err != nil {
+		return lease, err
+	}
+	_ = os.Mkdir(pidFileDir, os.ModeDir)
+	if err = os.WriteFile(reqPath, content, 0644); err != nil {
+		return lease, err
+	}
+	ticker := time.NewTicker(leasePollPeriod)
+	defer ticker.Stop()
+	for {
+		content, err = os.ReadFile(leasePath)
+		if err == nil {
+			_ = os.Remove(leasePath)
+			if err = json.Unmarshal(content, &lease); err != nil {
+				return lease, fmt.Errorf("failed to parse lease file %s: %w", leasePath, err)
+			}
+			return lease, nil
+		}
+		select {
+		case <-ctx.Done():
+			_ = os.Remove(reqPath)
+			return lease, fmt.Errorf("StoneWork has not responded to lease request: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.pantheon.tech/stonework/pkg/conc"
+)
+
+const testLeaseTTL = time.Hour
+
+func testLeasePlugin(leaseFile string, maxCnfIndex int) *Plugin {
+	p := testDiscoveryPlugin()
+	p.config = &Config{
+		SwModGrpcBasePort: defaultSwModGrpcBasePort,
+		SwModHttpBasePort: defaultSwModGrpcBasePort + maxCnfIndex + 1,
+		LeaseFile:         leaseFile,
+		LeaseTTL:          testLeaseTTL,
+	}
+	p.sw.modules = conc.NewMap[string, swModule]()
+	var err error
+	p.sw.leases, err = loadCnfLeases(leaseFile)
+	Expect(err).ToNot(HaveOccurred())
+	return p
+}
+
+func TestLeaseCnfIndex(t *testing.T) {
+	RegisterTestingT(t)
+	leaseFile := filepath.Join(t.TempDir(), "leases.json")
+	p := testLeasePlugin(leaseFile, 4)
+
+	// SW-Module loaded with static CNF index 1
+	p.sw.modules.Set("static", swModule{cnfMsLabel: "static", grpcPort: defaultSwModGrpcBasePort + 1})
+
+	tests := []struct {
+		cnfMsLabel string
+		cnfIndex   int
+		wantErr    error
+	}{
+		{cnfMsLabel: "bgp", cnfIndex: 2},
+		{cnfMsLabel: "ospf", cnfIndex: 3},
+		{cnfMsLabel: "bgp", cnfIndex: 2}, // the same lease is returned again
+		{cnfMsLabel: "isis", cnfIndex: 4},
+		{cnfMsLabel: "rip", wantErr: ErrCnfIndexesExhausted},
+	}
+	for _, test := range tests {
+		lease, err := p.leaseCnfIndex(test.cnfMsLabel)
+		if test.wantErr != nil {
+			Expect(errors.Is(err, test.wantErr)).To(BeTrue(), "lease for %s", test.cnfMsLabel)
+			continue
+		}
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lease).To(Equal(CnfLease{
+			MsLabel:  test.cnfMsLabel,
+			CnfIndex: test.cnfIndex,
+			GrpcPort: p.config.SwModGrpcBasePort + test.cnfIndex,
+			HttpPort: p.config.SwModHttpBasePort + test.cnfIndex,
+		}))
+	}
+
+	// leases are preserved across restart of StoneWork
+	p = testLeasePlugin(leaseFile, 4)
+	lease, err := p.leaseCnfIndex("ospf")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(lease.CnfIndex).To(Equal(3))
+	lease, err = p.leaseCnfIndex("rip")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(lease.CnfIndex).To(Equal(1)) // not used by a loaded SW-Module anymore
+}
+
+func TestExpireCnfLeases(t *testing.T) {
+	RegisterTestingT(t)
+	leaseFile := filepath.Join(t.TempDir(), "leases.json")
+	p := testLeasePlugin(leaseFile, 2)
+	for _, cnfMsLabel := range []string{"bgp", "ospf"} {
+		_, err := p.leaseCnfIndex(cnfMsLabel)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	_, err := p.leaseCnfIndex("isis")
+	Expect(errors.Is(err, ErrCnfIndexesExhausted)).To(BeTrue())
+
+	// lease of loaded SW-Module is renewed, lease of departed SW-Module expires after TTL
+	p.sw.modules.Set("bgp", swModule{cnfMsLabel: "bgp", grpcPort: defaultSwModGrpcBasePort + 1})
+	now := time.Now()
+	p.expireCnfLeases(now.Add(testLeaseTTL / 2))
+	Expect(p.sw.leases.leases).To(HaveLen(2))
+	p.expireCnfLeases(now.Add(testLeaseTTL + time.Minute))
+	Expect(p.sw.leases.leases).To(Equal(map[string]int{"bgp": 1}))
+
+	// released index is leased to another SW-Module
+	lease, err := p.leaseCnfIndex("isis")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(lease.CnfIndex).To(Equal(2))
+
+	// expiration is persisted
+	p = testLeasePlugin(leaseFile, 2)
+	Expect(p.sw.leases.leases).To(Equal(map[string]int{"bgp": 1, "isis": 2}))
+
+	// renewed lease expires once the SW-Module departs
+	p.sw.modules.Set("bgp", swModule{cnfMsLabel: "bgp", grpcPort: defaultSwModGrpcBasePort + 1})
+	p.sw.modules.Set("isis", swModule{cnfMsLabel: "isis", grpcPort: defaultSwModGrpcBasePort + 2})
+	later := time.Now().Add(2 * testLeaseTTL)
+	p.expireCnfLeases(later)
+	Expect(p.sw.leases.leases).To(Equal(map[string]int{"bgp": 1, "isis": 2}))
+	p.sw.modules.Del("isis")
+	p.expireCnfLeases(later.Add(testLeaseTTL - time.Minute))
+	Expect(p.sw.leases.leases).To(HaveKey("isis"))
+	p.expireCnfLeases(later.Add(testLeaseTTL))
+	Expect(p.sw.leases.leases).ToNot(HaveKey("isis"))
+}
+
+func TestHandleLeaseRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		reqFile   string
+		msLabel   string
+		wantLease bool
+	}{
+		{name: "valid request", reqFile: "bgp.lease-req", msLabel: "bgp", wantLease: true},
+		{name: "label of another SW-Module", reqFile: "bgp.lease-req", msLabel: "ospf"},
+		{name: "path separator", reqFile: "bgp.lease-req", msLabel: "../bgp"},
+		{name: "parent directory", reqFile: "...lease-req", msLabel: ".."},
+		{name: "backslash", reqFile: `a\b.lease-req`, msLabel: `a\b`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			dir := t.TempDir()
+			discoveryDir := filepath.Join(dir, "discovery")
+			Expect(os.Mkdir(discoveryDir, 0755)).To(Succeed())
+			p := testLeasePlugin("", 10)
+			reqPath := filepath.Join(discoveryDir, test.reqFile)
+			content, err := json.Marshal(&leaseRequest{MsLabel: test.msLabel})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.WriteFile(reqPath, content, 0644)).To(Succeed())
+			p.handleLeaseRequest(reqPath)
+
+			leases, err := filepath.Glob(filepath.Join(dir, "*", "*"+leaseFileExt))
+			Expect(err).ToNot(HaveOccurred())
+			outside, err := filepath.Glob(filepath.Join(dir, "*"+leaseFileExt))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outside).To(BeEmpty())
+			if test.wantLease {
+				Expect(leases).To(ConsistOf(filepath.Join(discoveryDir, test.msLabel+leaseFileExt)))
+				Expect(reqPath).ToNot(BeAnExistingFile())
+			} else {
+				Expect(leases).To(BeEmpty())
+				Expect(reqPath).To(BeAnExistingFile())
+			}
+		})
+	}
+}
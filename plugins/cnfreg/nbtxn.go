@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"google.golang.org/protobuf/proto"
+
+	pb "go.pantheon.tech/stonework/proto/cnfreg"
+)
+
+const (
+	// Retry of failed operations enforced for NB transactions committed through NBTxnScheduler.
+	// With exponential backoff the last attempt comes ~2 minutes after the transaction.
+	nbTxnRetryPeriod   = time.Second
+	nbTxnRetryMaxCount = 8
+)
+
+// nbTxnScheduler is KVScheduler given to the orchestrator of StoneWork. It commits every NB transaction
+// with retry of failed operations enabled, because proxied items may fail with errors that are resolved
+// only after the transaction (e.g. punts configured by Punt Manager with "sync-config").
+// With batching of proxied changes, changes of batched values are applied in SW-Modules before
+// the transaction is committed.
+type nbTxnScheduler struct {
+	kvs.KVScheduler
+	sync.Mutex // serializes commits with batched changes
+	plugin     *Plugin
+}
+
+// nbTxn is NB transaction started through nbTxnScheduler.
+type nbTxn struct {
+	kvs.Txn
+	scheduler *nbTxnScheduler
+	values    map[string]proto.Message // key -> value set in the transaction (nil = removed)
+}
+
+// NBTxnScheduler returns KVScheduler (wrapping the one of CNF Registry) that should be used by the orchestrator
+// of StoneWork to commit NB transactions. The transactions are committed with retry of failed operations
+// enabled (the retry requested by the caller is only extended to enough attempts).
+// Has to be called before the plugins are initialized.
+func (p *Plugin) NBTxnScheduler() kvs.KVScheduler {
+	if p.nbTxnScheduler == nil {
+		p.nbTxnScheduler = &nbTxnScheduler{KVScheduler: p.KVScheduler, plugin: p}
+	}
+	return p.nbTxnScheduler
+}
+
+// NBTxnsWithRetry returns true if NB transactions are committed through NBTxnScheduler,
+// i.e. with retry of failed operations enabled.
+func (p *Plugin) NBTxnsWithRetry() bool {
+	return p.nbTxnScheduler != nil
+}
+
+// StartNBTransaction starts NB transaction committed with retry enabled.
+func (s *nbTxnScheduler) StartNBTransaction() kvs.Txn {
+	return &nbTxn{
+		Txn:       s.KVScheduler.StartNBTransaction(),
+		scheduler: s,
+		values:    make(map[string]proto.Message),
+	}
+}
+
+// SetValue changes (non-derived) value.
+func (t *nbTxn) SetValue(key string, value proto.Message) kvs.Txn {
+	t.Txn.SetValue(key, value)
+	t.values[key] = value
+	return t
+}
+
+// Commit orders KVScheduler to execute the transaction with retry of failed operations enabled.
+// Changes of batched values are applied in SW-Modules first (one remote transaction per SW-Module)
+// and the changes which KVScheduler has not executed are reverted once the transaction is finished.
+// Resync and non-blocking transactions are not batched.
+func (t *nbTxn) Commit(ctx context.Context) (seqNum uint64, err error) {
+	ctx = withNBTxnRetry(ctx)
+	resyncType, _ := kvs.IsResync(ctx)
+	if !t.scheduler.plugin.batchesChanges() || resyncType != kvs.NotResync || kvs.IsNonBlockingTxn(ctx) {
+		return t.Txn.Commit(ctx)
+	}
+	t.scheduler.Lock()
+	defer t.scheduler.Unlock()
+	batched := t.scheduler.plugin.applyChangeBatches(t.values)
+	seqNum, err = t.Txn.Commit(ctx)
+	for _, module := range batched {
+		module.revertBatch()
+	}
+	return seqNum, err
+}
+
+// withNBTxnRetry enables retry of failed operations for the transaction, keeping the retry options
+// of the caller unless they allow fewer attempts.
+func withNBTxnRetry(ctx context.Context) context.Context {
+	retry, withRetry := kvs.IsWithRetry(ctx)
+	if !withRetry {
+		return kvs.WithRetry(ctx, nbTxnRetryPeriod, nbTxnRetryMaxCount, true)
+	}
+	if retry.MaxCount < nbTxnRetryMaxCount {
+		return kvs.WithRetry(ctx, retry.Period, nbTxnRetryMaxCount, retry.ExpBackoff)
+	}
+	return ctx
+}
+
+// batchesChanges returns true if changes of values proxied to SW-Modules are batched.
+func (p *Plugin) batchesChanges() bool {
+	return p.cnfMode == pb.CnfMode_STONEWORK && p.config != nil && p.config.BatchProxiedChanges
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.pantheon.tech/stonework/pkg/conc"
+	pb "go.pantheon.tech/stonework/proto/cnfreg"
+)
+
+// fakeScheduler executes NB transactions by calling execute (instead of the proxy descriptors).
+type fakeScheduler struct {
+	kvs.KVScheduler
+	execute func(ctx context.Context, values map[string]proto.Message)
+}
+
+type fakeTxn struct {
+	scheduler *fakeScheduler
+	values    map[string]proto.Message
+}
+
+func (s *fakeScheduler) StartNBTransaction() kvs.Txn {
+	return &fakeTxn{scheduler: s, values: make(map[string]proto.Message)}
+}
+
+func (t *fakeTxn) SetValue(key string, value proto.Message) kvs.Txn {
+	t.values[key] = value
+	return t
+}
+
+func (t *fakeTxn) Commit(ctx context.Context) (seqNum uint64, err error) {
+	t.scheduler.execute(ctx, t.values)
+	return 1, nil
+}
+
+func TestWithNBTxnRetry(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want kvs.RetryOpt
+	}{
+		{
+			name: "retry enabled",
+			ctx:  context.Background(),
+			want: kvs.RetryOpt{Period: nbTxnRetryPeriod, MaxCount: nbTxnRetryMaxCount, ExpBackoff: true},
+		},
+		{
+			name: "too few attempts extended",
+			ctx:  kvs.WithRetry(context.Background(), 3*time.Second, 2, false),
+			want: kvs.RetryOpt{Period: 3 * time.Second, MaxCount: nbTxnRetryMaxCount},
+		},
+		{
+			name: "retry of the caller kept",
+			ctx:  kvs.WithRetry(context.Background(), 3*time.Second, 20, true),
+			want: kvs.RetryOpt{Period: 3 * time.Second, MaxCount: 20, ExpBackoff: true},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			retry, withRetry := kvs.IsWithRetry(withNBTxnRetry(test.ctx))
+			Expect(withRetry).To(BeTrue())
+			Expect(*retry).To(Equal(test.want))
+		})
+	}
+}
+
+func TestNBTxnBatchedChanges(t *testing.T) {
+	RegisterTestingT(t)
+	module := newFakeModule()
+	p := testDiscoveryPlugin()
+	p.cnfMode = pb.CnfMode_STONEWORK
+	p.config = &Config{BatchProxiedChanges: true}
+	p.sw.proxies = conc.NewMap[string, *moduleProxy]()
+	p.sw.proxies.Set(module.proxy.cnfMsLabel, module.proxy)
+	scheduler := &fakeScheduler{}
+	p.KVScheduler = scheduler
+	nbScheduler := p.NBTxnScheduler()
+
+	// KVScheduler executes only the change of config/a, the change of config/b is reverted
+	scheduler.execute = func(ctx context.Context, values map[string]proto.Message) {
+		Expect(module.takeApplied()).To(HaveLen(1))
+		applied, err := module.proxy.batch.takeResult("config/a", values["config/a"], false)
+		Expect(applied).To(BeTrue())
+		Expect(err).ToNot(HaveOccurred())
+		module.proxy.setValue("config/a", values["config/a"])
+	}
+	_, err := nbScheduler.StartNBTransaction().
+		SetValue("config/a", wrapperspb.String("a")).
+		SetValue("config/b", wrapperspb.String("b")).
+		Commit(context.Background())
+	Expect(err).ToNot(HaveOccurred())
+	reverts := module.takeApplied()
+	Expect(reverts).To(HaveLen(1))
+	Expect(reverts[0]).To(HaveLen(1))
+	Expect(reverts[0]["config/b"].remove).To(BeTrue())
+
+	// resync and non-blocking transactions are not batched
+	scheduler.execute = func(ctx context.Context, values map[string]proto.Message) {
+		Expect(module.takeApplied()).To(BeEmpty())
+	}
+	for _, ctx := range []context.Context{
+		kvs.WithResync(context.Background(), kvs.FullResync, false),
+		kvs.WithoutBlocking(context.Background()),
+	} {
+		_, err = nbScheduler.StartNBTransaction().
+			SetValue("config/c", wrapperspb.String("c")).
+			Commit(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(module.takeApplied()).To(BeEmpty())
+	}
+}
@@ -21,9 +21,14 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"strconv"
 	"sync"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"go.ligato.io/cn-infra/v2/infra"
@@ -42,6 +47,9 @@ import (
 	"go.pantheon.tech/stonework/proto/puntmgr"
 )
 
+// ErrCnfNotLoaded is returned by StoneWorkAPI methods for CNF which is not (or no longer) loaded as SW-Module.
+var ErrCnfNotLoaded = errors.New("CNF is not loaded as StoneWork Module")
+
 // CNF Registry plugin allows to load a CNF module into the StoneWork (all-in-one VPP distribution; SW for short)
 // during the Init phase. CNF can be built as another image and run as a separate container.
 // This allows to enable/disable CNF without having to rebuild StoneWork docker image or the agent binary.
@@ -70,14 +78,18 @@ import (
 type Plugin struct {
 	Deps
 	pb.UnimplementedCnfDiscoveryServer
+	pb.UnimplementedCnfRegistryServer
 
 	cnfMode   pb.CnfMode
 	config    *Config
-	ipAddress net.IP // management IP address (discovered during Init)
+	ipAddress net.IP  // management IP address (discovered during Init)
+	tls       *cnfTLS // nil if TLS is not configured
 
 	// CNF-mode specific attributes
 	sw    swAttrs    // STONEWORK
 	swMod swModAttrs // STONEWORK_MODULE
+
+	nbTxnScheduler *nbTxnScheduler // nil if NB transactions are not committed through NBTxnScheduler
 }
 
 // Deps is a set of dependencies of the CNF Registry plugin
@@ -97,6 +109,8 @@ type Deps struct {
 type CnfDeps struct {
 	// CNF index that should be unique for each CNF.
 	// Allocate 1 for the first CNF and give +1 for each new CNF.
+	// SW-Module with lease-cnf-index enabled uses the index leased by StoneWork instead
+	// and falls back to this one only if StoneWork is unreachable (can be left undefined then).
 	CnfIndex int
 }
 
@@ -115,16 +129,26 @@ type PuntRequestsClb func(configItem proto.Message) *puntmgr.PuntRequests
 // item (apart from punt dependencies which are determined from the punt requests).
 type ItemDepsClb func(configItem proto.Message) []*pb.ConfigItemDependency
 
+// ValidateClb is used to validate a given configuration item already in StoneWork, before it is applied.
+// Validation failure should be returned as kvs.InvalidValueError, so that the invalid fields are reported.
+type ValidateClb func(configItem proto.Message) error
+
+// StateDumpClb is used to read the current operational state of a given state model.
+type StateDumpClb func() ([]proto.Message, error)
+
 // CnfModelCallbacks groups (optional) callbacks that can be assigned to a model registration.
 type CnfModelCallbacks struct {
 	PuntRequests     PuntRequestsClb
 	ItemDependencies ItemDepsClb
+	Validate         ValidateClb
 }
 
 // API to be used by CNF (standalone or as SW-Module)
 type CnfAPI interface {
 	// Get mode in which the CNF operates with respect to other CNFs.
 	GetCnfMode() pb.CnfMode
+	// Returns CNF index of this CNF (leased from StoneWork or the static one from CnfDeps).
+	GetCnfIndex() int
 	// Returns gRPC port that should be used by this CNF.
 	// Not to be used by StoneWork or a standalone CNF (they should respect what is in grpc.conf).
 	GetGrpcPort() (port int)
@@ -137,10 +161,24 @@ type CnfAPI interface {
 	// Can be used to apply configuration into the VPP
 	// (determined by the operation of a CNF, e.g. IP routes received over BGP).
 	GetSWCfgClient() (cfgClient client.GenericClient, err error)
+	// Returns API capabilities of StoneWork (e.g. supported punt types).
+	// Returns nil until the CNF is discovered or if StoneWork predates the capability negotiation.
+	GetSWCapabilities() *pb.ApiCapabilities
+	// VerifySWPeer checks that the caller of a gRPC method served by SW-Module is StoneWork
+	// (always succeeds without TLS).
+	VerifySWPeer(ctx context.Context) error
 	// RegisterCnfModel registers configuration model implemented by the CNF.
 	// This method should be used by a SW-Module CNF in the Init phase to convey the definition of a CNF NB API model
 	// into StoneWork, which will then act as a proxy for all the operations over that model.
 	RegisterCnfModel(model models.KnownModel, descriptor *kvs.KVDescriptor, callbacks *CnfModelCallbacks) error
+	// RegisterCnfStateModel registers model of operational state of the CNF (e.g. learned routes).
+	// This method should be used by a SW-Module CNF in the Init phase. StoneWork then reads the state
+	// of the model using the given callback, whenever it is retrieved from StoneWork.
+	RegisterCnfStateModel(model models.KnownModel, dump StateDumpClb) error
+	// RegisterCnfGrpcService announces custom gRPC service of the CNF (served by the CNF on its gRPC server)
+	// to StoneWork, which then makes it available through its gRPC proxy.
+	// This method should be used by a SW-Module CNF in the Init phase.
+	RegisterCnfGrpcService(serviceName string) error
 }
 
 // API to be used by StoneWork.
@@ -149,6 +187,9 @@ type StoneWorkAPI interface {
 	GetCnfGrpcConn(cnfMsLabel string) (conn grpc.ClientConnInterface, err error)
 	// Returns remote configuration client connected with the given SW-Module CNF.
 	GetCnfCfgClient(cnfMsLabel string) (cfgClient client.GenericClient, err error)
+	// Returns true if NB transactions of StoneWork are committed with retry of failed operations enabled
+	// (i.e. the orchestrator uses KVScheduler returned by NBTxnScheduler).
+	NBTxnsWithRetry() bool
 }
 
 // APIs of Punt Manager that are used by CNF registry.
@@ -159,24 +200,48 @@ type PuntManagerAPI interface {
 	DelPunt(cnfMsLabel, key string, label string) error
 	// GetPuntDependencies returns dependencies that have to be satisfied before the punt can be added.
 	GetPuntDependencies(cnfMsLabel string, punt *puntmgr.PuntRequest) (deps []kvs.Dependency)
+	// ResyncCnf re-sends metadata of all punts and the CNF side of their interconnects to the (restarted) CNF.
+	ResyncCnf(cnfMsLabel string) error
+	// GetSupportedPunts returns all punt types and interconnect types supported by the Punt Manager.
+	GetSupportedPunts() (puntTypes []puntmgr.PuntRequest_PuntType, icTypes []puntmgr.PuntRequest_InterconnectType)
 }
 
 // Attributes specific to StoneWork (i.e. not used by CNF).
 type swAttrs struct {
-	modules conc.Map[string, swModule] // key = cnf microservice label
+	modules       conc.Map[string, swModule]     // key = cnf microservice label
+	proxies       conc.Map[string, *moduleProxy] // key = cnf microservice label, kept after module departs
+	registrations conc.Map[string, time.Time]    // key = cnf microservice label, value = expiration
+	rejected      conc.Map[string, swModule]     // key = cnf microservice label, incompatible SW-Modules
+	moduleLocks   conc.Map[string, *sync.Mutex]  // key = cnf microservice label, serializes (un)loading
+	leases        *cnfLeases
+	moduleNotif   *moduleNotifDescriptor
+
+	// reverse proxy to HTTP servers of SW-Modules
+	httpTransport     *http.Transport
+	httpTLSTransports conc.Map[string, *http.Transport] // key = cnf microservice label
+
+	// proxy to custom gRPC services of SW-Modules (nil if not enabled)
+	grpcProxy *grpc.Server
 }
 
 // CNF used as a StoneWork Module.
 type swModule struct {
-	pid        int
-	cnfMsLabel string
-	ipAddress  string
-	grpcPort   int
-	httpPort   int
-	grpcConn   *grpc.ClientConn
-	cnfClient  pb.CnfDiscoveryClient
-	cfgClient  client.GenericClient
-	cnfModels  []cnfModel
+	pid         int
+	instance    string // identifier of the SW-Module process (only SW-Modules loaded through RegisterCnf)
+	source      string // pid file path or the identifier used by other discovery backend
+	cnfMsLabel  string
+	ipAddress   string
+	grpcPort    int
+	httpPort    int
+	grpcConn    *grpc.ClientConn
+	cnfClient   pb.CnfDiscoveryClient
+	cfgClient   client.GenericClient
+	cnfModels   []cnfModel
+	stateModels []*models.ModelInfo
+	// custom gRPC services proxied to the CNF
+	grpcServices []string
+	compat       *moduleCompat
+	proxy        *moduleProxy
 }
 
 // Attributes specific to StoneWork Module (i.e. not used by standalone CNF or StoneWork itself).
@@ -188,7 +253,17 @@ type swModAttrs struct {
 	swHttpPort  int
 	swGrpcConn  grpc.ClientConnInterface
 	swCfgClient client.GenericClient
+	swCaps      *pb.ApiCapabilities
 	models      []exposedModel
+	stateModels []exposedStateModel
+	// custom gRPC services announced to StoneWork
+	grpcServices []string
+	lease        *CnfLease // nil if static CnfIndex is used
+
+	// registration through the CnfRegistry service of StoneWork (nil if pid file is used instead)
+	registry         pb.CnfRegistryClient
+	instance         string // identifies this process in registrations
+	registrationDone chan struct{}
 }
 
 type exposedModel struct {
@@ -197,43 +272,59 @@ type exposedModel struct {
 	callbacks  *CnfModelCallbacks
 }
 
+type exposedStateModel struct {
+	model models.KnownModel
+	dump  StateDumpClb
+}
+
 // Model exposed by a CNF.
 type cnfModel struct {
 	info         *models.ModelInfo
 	withPunt     bool
 	withDeps     bool
 	withRetrieve bool
+	withValidate bool
 }
 
 // Init initializes internal attributes and depending on the mode does the following:
 // case STONEWORK_MODULE:
+//   - leases CNF index from StoneWork (if enabled)
 //   - registers gRPC handler for DiscoverCnf and GetPuntRequests
 //
 // case STONEWORK:
+//   - registers gRPC handler for RegisterCnf, DeregisterCnf, Heartbeat and LeaseCnfIndex
 //   - waits few seconds for all CNFs to write pid files
 //   - then for each CNF:
 //   - creates grpcConnection with the CNF
 //   - obtains models using the meta service
 //   - register models (exposed by Cnf)
 //   - creates CnfDescriptorProxy for each module
+//   - keeps monitoring modules and removes those that depart
 func (p *Plugin) Init() (err error) {
-	if p.GetCnfMode() != pb.CnfMode_STONEWORK {
+	p.config, err = p.loadConfig()
+	if err != nil {
+		return err
+	}
+	if p.cnfMode == pb.CnfMode_STANDALONE ||
+		(p.cnfMode == pb.CnfMode_STONEWORK_MODULE && !p.config.LeaseCnfIndex) {
 		// check CNF dependencies
 		if p.CnfIndex == 0 {
 			return errors.New("CnfIndex not defined")
 		}
 	}
-	p.config, err = p.loadConfig()
+	p.tls, err = loadTLS(p.config.TLS)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to load TLS configuration: %w", err)
 	}
 
 	// discover management IP address
-	// TODO: support FQDN (e.g. K8s service name)
+	// (not needed if the address to advertise is configured, e.g. K8s service name)
 	p.ipAddress, err = p.discoverMyIP()
 	if err != nil {
-		if p.cnfMode == pb.CnfMode_STANDALONE {
+		if p.cnfMode == pb.CnfMode_STANDALONE || p.config.AdvertisedAddress != "" ||
+			(p.cnfMode == pb.CnfMode_STONEWORK_MODULE && p.config.StoneWorkAddress != "") {
 			// Standalone CNF does not really need management IP address
+			// (and registered SW-Module is reachable on the address from which it registers)
 			p.Log.Warn(err)
 			err = nil
 		} else {
@@ -245,11 +336,22 @@ func (p *Plugin) Init() (err error) {
 
 	switch p.cnfMode {
 	case pb.CnfMode_STONEWORK_MODULE:
+		if p.config.LeaseCnfIndex {
+			if err = p.obtainCnfLease(); err != nil {
+				return err
+			}
+		}
 		// inject gRPC and HTTP ports to use by SW-Module
 		p.GRPCPlugin.Config.Endpoint = fmt.Sprintf("0.0.0.0:%d", p.GetGrpcPort())
 		if p.HTTPPlugin != nil {
 			p.HTTPPlugin.Config.Endpoint = fmt.Sprintf("0.0.0.0:%d", p.GetHttpPort())
 		}
+		// gRPC server is created before this plugin is initialized, TLS has to be configured in grpc.conf
+		if p.tls != nil {
+			if err = checkServerTLS(p.GRPCPlugin.Config); err != nil {
+				return err
+			}
+		}
 
 		// serve CnfDiscovery methods
 		grpcServer := p.GRPCPlugin.GetServer()
@@ -259,41 +361,120 @@ func (p *Plugin) Init() (err error) {
 		pb.RegisterCnfDiscoveryServer(grpcServer, p)
 
 	case pb.CnfMode_STONEWORK:
+		if p.config.BatchProxiedChanges && !p.NBTxnsWithRetry() {
+			// batches are applied by the KVScheduler used for NB transactions
+			return errors.New("\"batch-proxied-changes\" requires NB transactions committed through " +
+				"KVScheduler returned by NBTxnScheduler")
+		}
 		p.sw.modules = conc.NewMap[string, swModule]()
+		p.sw.proxies = conc.NewMap[string, *moduleProxy]()
+		p.sw.registrations = conc.NewMap[string, time.Time]()
+		p.sw.rejected = conc.NewMap[string, swModule]()
+		p.sw.moduleLocks = conc.NewMap[string, *sync.Mutex]()
+		p.sw.httpTLSTransports = conc.NewMap[string, *http.Transport]()
+		p.sw.leases, err = loadCnfLeases(p.config.LeaseFile)
+		if err != nil {
+			return err
+		}
 		p.registerHandlers(p.HTTPPlugin)
+		if p.config.HTTPProxy != nil && p.HTTPPlugin != nil {
+			if err = p.registerHTTPProxy(p.HTTPPlugin); err != nil {
+				return err
+			}
+		}
+		// serve CnfRegistry methods
+		grpcServer := p.GRPCPlugin.GetServer()
+		if grpcServer == nil {
+			return errors.New("gRPC server is not initialized")
+		}
+		pb.RegisterCnfRegistryServer(grpcServer, p)
+		// register descriptor for notifications about availability of SW-Modules
+		var kvDescr *kvs.KVDescriptor
+		p.sw.moduleNotif, kvDescr = newModuleNotifDescriptor(p.KVScheduler,
+			p.Log.NewLogger(ModuleNotifDescriptorName))
+		if err = p.KVScheduler.RegisterKVDescriptor(kvDescr); err != nil {
+			return err
+		}
 		// CNF discovery
 		go p.cnfDiscovery(make(chan struct{}))
+		if p.config.K8sDiscovery != nil {
+			k8sClient, namespace, err := newK8sClient()
+			if err != nil {
+				return fmt.Errorf("failed to initialize Kubernetes discovery: %w", err)
+			}
+			go p.pollDiscovery("k8s", p.config.K8sDiscovery.PollInterval,
+				p.k8sDiscovery(k8sClient, namespace, p.config.K8sDiscovery), make(chan struct{}))
+		}
+		if p.config.DNSDiscovery != nil {
+			go p.pollDiscovery("dns", p.config.DNSDiscovery.PollInterval,
+				p.dnsDiscovery(net.DefaultResolver, p.config.DNSDiscovery), make(chan struct{}))
+		}
+		// detection of departed SW-Modules
+		go p.monitorModules(make(chan struct{}))
 	}
 	return nil
 }
 
-// AfterInit is used by CNF-Module to write pid file under a known directory for StoneWork to discover it.
+// AfterInit is used by CNF-Module to write pid file under a known directory for StoneWork to discover it,
+// or to register with StoneWork through the CnfRegistry service if the address of StoneWork is configured.
 func (p *Plugin) AfterInit() (err error) {
 	if p.cnfMode == pb.CnfMode_STONEWORK_MODULE {
+		if p.config.StoneWorkAddress != "" {
+			return p.startRegistration()
+		}
 		err = p.writePidFile()
 		if err != nil {
 			return err
 		}
 	}
+	if p.cnfMode == pb.CnfMode_STONEWORK && p.config.GRPCProxy != nil {
+		return p.startGrpcProxy()
+	}
 	return nil
 }
 
-// Close is NOOP.
+// Close deregisters SW-Module registered through the CnfRegistry service and stops the gRPC proxy of StoneWork.
 func (p *Plugin) Close() error {
+	switch p.cnfMode {
+	case pb.CnfMode_STONEWORK_MODULE:
+		p.stopRegistration()
+	case pb.CnfMode_STONEWORK:
+		p.stopGrpcProxy()
+	}
 	return nil
 }
 
+// advertisedAddress returns address (IP or hostname) under which this CNF / StoneWork is reachable
+// by other CNFs.
+func (p *Plugin) advertisedAddress() string {
+	if p.config.AdvertisedAddress != "" {
+		return p.config.AdvertisedAddress
+	}
+	return p.ipAddress.String()
+}
+
 // Get mode in which the CNF operates with respect to other CNFs.
 func (p *Plugin) GetCnfMode() pb.CnfMode {
 	return p.cnfMode
 }
 
+// Returns CNF index of this CNF (leased from StoneWork or the static one from CnfDeps).
+func (p *Plugin) GetCnfIndex() int {
+	if p.swMod.lease != nil {
+		return p.swMod.lease.CnfIndex
+	}
+	return p.CnfIndex
+}
+
 // Returns gRPC port that should be used by this CNF.
 // Not to be used by StoneWork or a standalone CNF (they should respect what is in grpc.conf).
 func (p *Plugin) GetGrpcPort() (port int) {
 	if p.cnfMode != pb.CnfMode_STONEWORK_MODULE {
 		panic(fmt.Errorf("method GetGrpcPort is not available in the CNF mode %v", p.cnfMode))
 	}
+	if p.swMod.lease != nil {
+		return p.swMod.lease.GrpcPort
+	}
 	return p.config.SwModGrpcBasePort + p.CnfIndex
 }
 
@@ -303,6 +484,9 @@ func (p *Plugin) GetHttpPort() (port int) {
 	if p.cnfMode != pb.CnfMode_STONEWORK_MODULE {
 		panic(fmt.Errorf("method GetHttpPort is not available in the CNF mode %v", p.cnfMode))
 	}
+	if p.swMod.lease != nil {
+		return p.swMod.lease.HttpPort
+	}
 	return p.config.SwModHttpBasePort + p.CnfIndex
 }
 
@@ -330,6 +514,17 @@ func (p *Plugin) GetSWCfgClient() (cfgClient client.GenericClient, err error) {
 	return p.swMod.swCfgClient, nil
 }
 
+// Returns API capabilities of StoneWork (e.g. supported punt types).
+// Returns nil until the CNF is discovered or if StoneWork predates the capability negotiation.
+func (p *Plugin) GetSWCapabilities() *pb.ApiCapabilities {
+	if p.cnfMode != pb.CnfMode_STONEWORK_MODULE {
+		panic(fmt.Errorf("method GetSWCapabilities is not available in the CNF mode %v", p.cnfMode))
+	}
+	p.swMod.Lock()
+	defer p.swMod.Unlock()
+	return p.swMod.swCaps
+}
+
 // RegisterCnfModel registers configuration model implemented by the CNF.
 // This method should be used by a SW-Module CNF in the Init phase to convey the definition of a CNF NB API model
 // into StoneWork, which will then act as a proxy for all the operations over that model.
@@ -356,6 +551,31 @@ func (p *Plugin) RegisterCnfModel(model models.KnownModel, descriptor *kvs.KVDes
 	return nil
 }
 
+// RegisterCnfStateModel registers model of operational state of the CNF (e.g. learned routes).
+// This method should be used by a SW-Module CNF in the Init phase. StoneWork then reads the state
+// of the model using the given callback, whenever it is retrieved from StoneWork.
+func (p *Plugin) RegisterCnfStateModel(model models.KnownModel, dump StateDumpClb) error {
+	switch p.cnfMode {
+	case pb.CnfMode_STONEWORK_MODULE:
+		p.swMod.Lock()
+		defer p.swMod.Unlock()
+		if p.swMod.discovered {
+			return errors.New("CNF has been already discovered by StoneWork")
+		}
+		p.swMod.stateModels = append(p.swMod.stateModels, exposedStateModel{
+			model: model,
+			dump:  dump,
+		})
+
+	case pb.CnfMode_STANDALONE:
+		// nothing to do
+		return nil
+	case pb.CnfMode_STONEWORK:
+		panic(fmt.Errorf("method RegisterCnfStateModel is not available in the CNF mode %v", p.cnfMode))
+	}
+	return nil
+}
+
 // Returns gRPC connection established with the given SW-Module CNF.
 func (p *Plugin) GetCnfGrpcConn(cnfMsLabel string) (conn grpc.ClientConnInterface, err error) {
 	if p.cnfMode != pb.CnfMode_STONEWORK {
@@ -363,7 +583,7 @@ func (p *Plugin) GetCnfGrpcConn(cnfMsLabel string) (conn grpc.ClientConnInterfac
 	}
 	swModule, loaded := p.sw.modules.Get(cnfMsLabel)
 	if !loaded {
-		return nil, fmt.Errorf("CNF %s is not loaded as StoneWork Module", cnfMsLabel)
+		return nil, fmt.Errorf("%w: %s", ErrCnfNotLoaded, cnfMsLabel)
 	}
 	if swModule.grpcConn == nil {
 		return nil, fmt.Errorf("gRPC connection with CNF %s is not yet established", cnfMsLabel)
@@ -379,7 +599,7 @@ func (p *Plugin) GetCnfCfgClient(cnfMsLabel string) (cfgClient client.GenericCli
 	// No need to lock p.sw - it is not changed anymore after Init
 	swModule, loaded := p.sw.modules.Get(cnfMsLabel)
 	if !loaded {
-		return nil, fmt.Errorf("CNF %s is not loaded as StoneWork Module", cnfMsLabel)
+		return nil, fmt.Errorf("%w: %s", ErrCnfNotLoaded, cnfMsLabel)
 	}
 	if swModule.cfgClient == nil {
 		return nil, fmt.Errorf("configuration client for CNF %s does not exist yet", cnfMsLabel)
@@ -392,42 +612,87 @@ func (p *Plugin) GetCnfCfgClient(cnfMsLabel string) (cfgClient client.GenericCli
 func (p *Plugin) DiscoverCnf(ctx context.Context, req *pb.DiscoverCnfReq) (resp *pb.DiscoverCnfResp, err error) {
 	p.Log.Debugf("Handling DiscoverCnf(%+v)", req)
 	resp = &pb.DiscoverCnfResp{CnfMsLabel: p.ServiceLabel.GetAgentLabel()}
+	if err = p.VerifySWPeer(ctx); err != nil {
+		return resp, err
+	}
 	p.swMod.Lock()
 	defer p.swMod.Unlock()
 	if p.swMod.discovered {
 		return resp, errors.New("CNF has been already discovered")
 	}
-	for _, expModel := range p.swMod.models {
-		resp.ConfigModels = append(resp.ConfigModels, &pb.DiscoverCnfResp_ConfigModel{
-			ProtoName:    expModel.model.ProtoName(),
-			WithPunt:     expModel.callbacks != nil && expModel.callbacks.PuntRequests != nil,
-			WithDeps:     expModel.callbacks != nil && expModel.callbacks.ItemDependencies != nil,
-			WithRetrieve: expModel.descriptor.Retrieve != nil,
-		})
+	if swCaps := req.GetSwCapabilities(); swCaps != nil {
+		if err = checkApiVersion(swCaps); err != nil {
+			return resp, status.Error(codes.FailedPrecondition, err.Error())
+		}
 	}
+	resp.ConfigModels = p.exposedConfigModels()
+	resp.StateModels = p.exposedStateModels()
+	resp.GrpcServices = p.swMod.grpcServices
+	resp.Capabilities = p.capabilities()
 	p.swMod.discovered = true
+	p.swMod.swCaps = req.GetSwCapabilities()
 	p.swMod.swIpAddress = req.GetSwIpAddress()
 	p.swMod.swGrpcPort = int(req.GetSwGrpcPort())
 	p.swMod.swHttpPort = int(req.GetSwHttpPort())
 
 	// establish connection with StoneWork asynchronously in the background
 	// (at this point StoneWork is still in the Init phase and the gRPC server is not listening yet)
+	var tlsIdentity string
+	if p.tls != nil {
+		tlsIdentity = p.config.TLS.StoneWorkIdentity
+	}
 	p.swMod.swGrpcConn, err = grpc.Dial(
-		fmt.Sprintf("%s:%d", p.swMod.swIpAddress, p.swMod.swGrpcPort), grpc.WithInsecure())
+		net.JoinHostPort(p.swMod.swIpAddress, strconv.Itoa(p.swMod.swGrpcPort)), p.tls.dialOption(tlsIdentity))
 	p.swMod.swCfgClient, err = remoteclient.NewClientGRPC(p.swMod.swGrpcConn)
 	return resp, err
 }
 
+// exposedConfigModels describes models exposed by this SW-Module for StoneWork.
+// The method should be called with swMod locked.
+func (p *Plugin) exposedConfigModels() (configModels []*pb.DiscoverCnfResp_ConfigModel) {
+	for _, expModel := range p.swMod.models {
+		configModels = append(configModels, &pb.DiscoverCnfResp_ConfigModel{
+			ProtoName:    expModel.model.ProtoName(),
+			WithPunt:     expModel.callbacks != nil && expModel.callbacks.PuntRequests != nil,
+			WithDeps:     expModel.callbacks != nil && expModel.callbacks.ItemDependencies != nil,
+			WithRetrieve: expModel.descriptor.Retrieve != nil,
+			WithValidate: expModel.callbacks != nil && expModel.callbacks.Validate != nil,
+		})
+	}
+	return configModels
+}
+
+// exposedStateModels describes state models exposed by this SW-Module for StoneWork.
+// The method should be called with swMod locked.
+func (p *Plugin) exposedStateModels() (stateModels []*pb.DiscoverCnfResp_StateModel) {
+	for _, expModel := range p.swMod.stateModels {
+		stateModels = append(stateModels, &pb.DiscoverCnfResp_StateModel{
+			ProtoName: expModel.model.ProtoName(),
+		})
+	}
+	return stateModels
+}
+
 // GetPuntRequests is served by CNFRegistry of a SW-Module CNF and returns the set of packet punting
 // requests corresponding to the given configuration item.
 func (p *Plugin) GetPuntRequests(ctx context.Context, item *generic.Item) (puntReqs *puntmgr.PuntRequests, err error) {
 	p.Log.Debugf("Handling GetPuntRequests(%+v)", item)
 	puntReqs = &puntmgr.PuntRequests{}
+	if err = p.VerifySWPeer(ctx); err != nil {
+		return puntReqs, err
+	}
 	p.swMod.Lock()
 	defer p.swMod.Unlock()
 	if !p.swMod.discovered {
 		return puntReqs, errors.New("CNF has not been yet discovered, execute DiscoverCnf first")
 	}
+	return p.puntRequests(item)
+}
+
+// puntRequests returns punt requests of the item using the PuntRequests callback registered for its model.
+// The method should be called with swMod locked.
+func (p *Plugin) puntRequests(item *generic.Item) (puntReqs *puntmgr.PuntRequests, err error) {
+	puntReqs = &puntmgr.PuntRequests{}
 
 	// find PuntRequests callback corresponding to the model of the item
 	model, err := models.GetModelForItem(item)
@@ -461,16 +726,26 @@ func (p *Plugin) GetPuntRequests(ctx context.Context, item *generic.Item) (puntR
 func (p *Plugin) GetItemDependencies(ctx context.Context, item *generic.Item) (itemDeps *pb.GetDependenciesResp, err error) {
 	p.Log.Debugf("Handling GetItemDependencies(%+v)", item)
 	itemDeps = &pb.GetDependenciesResp{}
+	if err = p.VerifySWPeer(ctx); err != nil {
+		return itemDeps, err
+	}
 	p.swMod.Lock()
 	defer p.swMod.Unlock()
 	if !p.swMod.discovered {
 		return itemDeps, errors.New("CNF has not been yet discovered, execute DiscoverCnf first")
 	}
+	itemDeps.Dependencies, err = p.itemDependencies(item)
+	return itemDeps, err
+}
 
+// itemDependencies returns dependencies of the item using the ItemDependencies callback registered
+// for its model.
+// The method should be called with swMod locked.
+func (p *Plugin) itemDependencies(item *generic.Item) (deps []*pb.ConfigItemDependency, err error) {
 	// find ItemDependencies callback corresponding to the model of the item
 	model, err := models.GetModelForItem(item)
 	if err != nil {
-		return itemDeps, fmt.Errorf("failed to get model: %w", err)
+		return nil, fmt.Errorf("failed to get model: %w", err)
 	}
 	var itemDepsClb ItemDepsClb
 	for _, expModel := range p.swMod.models {
@@ -482,13 +757,89 @@ func (p *Plugin) GetItemDependencies(ctx context.Context, item *generic.Item) (i
 		}
 	}
 	if itemDepsClb == nil {
-		return itemDeps, fmt.Errorf("no dependencies required for item %v: %w",
+		return nil, fmt.Errorf("no dependencies required for item %v: %w",
 			item.GetId(), err)
 	}
 	value, err := models.UnmarshalItem(item)
 	if err != nil {
-		return itemDeps, fmt.Errorf("UnmarshalItem failed: %w", err)
+		return nil, fmt.Errorf("UnmarshalItem failed: %w", err)
+	}
+	return itemDepsClb(value), nil
+}
+
+// GetItemsInfo is served by CNFRegistry of a SW-Module CNF and returns punt requests and/or dependencies
+// of multiple configuration items at once.
+func (p *Plugin) GetItemsInfo(ctx context.Context, req *pb.GetItemsInfoReq) (resp *pb.GetItemsInfoResp, err error) {
+	p.Log.Debugf("Handling GetItemsInfo(%d items)", len(req.GetItems()))
+	resp = &pb.GetItemsInfoResp{}
+	if err = p.VerifySWPeer(ctx); err != nil {
+		return resp, err
+	}
+	p.swMod.Lock()
+	defer p.swMod.Unlock()
+	if !p.swMod.discovered {
+		return resp, errors.New("CNF has not been yet discovered, execute DiscoverCnf first")
+	}
+	for _, item := range req.GetItems() {
+		info := &pb.GetItemsInfoResp_ItemInfo{}
+		var itemErr error
+		if req.GetWithPuntRequests() {
+			info.PuntRequests, itemErr = p.puntRequests(item)
+		}
+		if itemErr == nil && req.GetWithDependencies() {
+			info.Dependencies, itemErr = p.itemDependencies(item)
+		}
+		if itemErr != nil {
+			info = &pb.GetItemsInfoResp_ItemInfo{Error: itemErr.Error()}
+		}
+		resp.Items = append(resp.Items, info)
+	}
+	return resp, nil
+}
+
+// ValidateItem is served by CNFRegistry of a SW-Module CNF and validates the given configuration item
+// using the Validate callback registered for its model.
+func (p *Plugin) ValidateItem(ctx context.Context, item *generic.Item) (resp *pb.ValidateItemResp, err error) {
+	p.Log.Debugf("Handling ValidateItem(%+v)", item)
+	resp = &pb.ValidateItemResp{}
+	if err = p.VerifySWPeer(ctx); err != nil {
+		return resp, err
+	}
+	p.swMod.Lock()
+	defer p.swMod.Unlock()
+	if !p.swMod.discovered {
+		return resp, errors.New("CNF has not been yet discovered, execute DiscoverCnf first")
+	}
+
+	// find Validate callback corresponding to the model of the item
+	model, err := models.GetModelForItem(item)
+	if err != nil {
+		return resp, fmt.Errorf("failed to get model: %w", err)
+	}
+	var validateClb ValidateClb
+	for _, expModel := range p.swMod.models {
+		if expModel.model.Name() == model.Name() {
+			if expModel.callbacks != nil {
+				validateClb = expModel.callbacks.Validate
+			}
+			break
+		}
+	}
+	if validateClb == nil {
+		return resp, fmt.Errorf("validation not supported for item %v", item.GetId())
+	}
+	value, err := models.UnmarshalItem(item)
+	if err != nil {
+		return resp, fmt.Errorf("UnmarshalItem failed: %w", err)
+	}
+	if err = validateClb(value); err != nil {
+		var invalidValueErr *kvs.InvalidValueError
+		if errors.As(err, &invalidValueErr) {
+			resp.Error = invalidValueErr.GetValidationError().Error()
+			resp.InvalidFields = invalidValueErr.GetInvalidFields()
+		} else {
+			resp.Error = err.Error()
+		}
 	}
-	itemDeps.Dependencies = itemDepsClb(value)
-	return itemDeps, nil
+	return resp, nil
 }
@@ -18,7 +18,10 @@ package cnfreg
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"go.ligato.io/cn-infra/v2/logging"
 	"google.golang.org/protobuf/proto"
@@ -26,12 +29,165 @@ import (
 	"go.ligato.io/vpp-agent/v3/client"
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
 
 	pb "go.pantheon.tech/stonework/proto/cnfreg"
 	"go.pantheon.tech/stonework/proto/puntmgr"
 )
 
-// The function initializes proxy descriptor for every config model exposed by the given CNF.
+// moduleProxy is shared by all proxy descriptors of a SW-Module. Descriptors are registered with KVScheduler
+// only once, when the SW-Module restarts (or re-appears after departure) only the connection is replaced.
+type moduleProxy struct {
+	sync.Mutex
+	log         logging.Logger
+	cnfMsLabel  string
+	rpcTimeout  time.Duration // deadline for calls of the SW-Module
+	breaker     *circuitBreaker
+	available   bool
+	cnfClient   pb.CnfDiscoveryClient
+	cfgClient   client.GenericClient
+	manager     generic.ManagerServiceClient
+	compat      *moduleCompat
+	batch       *changeBatch             // nil if changes are not batched
+	descriptors map[string]struct{}      // names of registered descriptors
+	values      map[string]proto.Message // values configured in the SW-Module, key = value key
+	cache       map[string]*itemInfo     // info obtained from the SW-Module, key = value key
+	generation  uint64                   // incremented whenever the SW-Module (re)connects
+}
+
+func newModuleProxy(log logging.Logger, cnfMsLabel string, config *Config) *moduleProxy {
+	m := &moduleProxy{
+		log:        log,
+		cnfMsLabel: cnfMsLabel,
+		rpcTimeout: config.RPCTimeout,
+		breaker: &circuitBreaker{
+			threshold: config.BreakerThreshold,
+			cooldown:  config.BreakerCooldown,
+			now:       time.Now,
+		},
+		descriptors: make(map[string]struct{}),
+		values:      make(map[string]proto.Message),
+		cache:       make(map[string]*itemInfo),
+	}
+	if config.BatchProxiedChanges {
+		m.batch = newChangeBatch(m)
+	}
+	return m
+}
+
+// connect makes the SW-Module available using the given clients.
+func (m *moduleProxy) connect(cnfClient pb.CnfDiscoveryClient, cfgClient client.GenericClient,
+	manager generic.ManagerServiceClient, compat *moduleCompat) {
+	m.Lock()
+	defer m.Unlock()
+	m.available = true
+	m.cnfClient = cnfClient
+	m.cfgClient = cfgClient
+	m.manager = manager
+	m.compat = compat
+	// restarted SW-Module may answer differently
+	m.cache = make(map[string]*itemInfo)
+	m.generation++
+}
+
+// checkPunt returns error if the punt cannot be used with the SW-Module.
+func (m *moduleProxy) checkPunt(puntReq *puntmgr.PuntRequest) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.compat.checkPunt(puntReq)
+}
+
+// disconnect marks the SW-Module as unavailable.
+func (m *moduleProxy) disconnect() {
+	m.Lock()
+	defer m.Unlock()
+	m.available = false
+}
+
+// clients returns clients of the SW-Module, ok is false if the SW-Module is not available.
+func (m *moduleProxy) clients() (cnfClient pb.CnfDiscoveryClient, cfgClient client.GenericClient, ok bool) {
+	m.Lock()
+	defer m.Unlock()
+	return m.cnfClient, m.cfgClient, m.available
+}
+
+// dumpState returns the state of the SW-Module (with the RPC deadline, unlike GenericClient.DumpState).
+func (m *moduleProxy) dumpState() ([]*generic.StateItem, error) {
+	m.Lock()
+	manager := m.manager
+	m.Unlock()
+	var resp *generic.DumpStateResponse
+	err := m.call("DumpState", func(ctx context.Context) (err error) {
+		resp, err = manager.DumpState(ctx, &generic.DumpStateRequest{})
+		return err
+	})
+	return resp.GetItems(), err
+}
+
+// setValue records value configured in the SW-Module (nil = removed).
+func (m *moduleProxy) setValue(key string, value proto.Message) {
+	m.Lock()
+	defer m.Unlock()
+	if value == nil {
+		delete(m.values, key)
+	} else {
+		m.values[key] = value
+	}
+}
+
+// hasDescriptors returns true if some proxy descriptors are already registered for the SW-Module.
+func (m *moduleProxy) hasDescriptors() bool {
+	m.Lock()
+	defer m.Unlock()
+	return len(m.descriptors) > 0
+}
+
+// replay re-applies all values configured in the SW-Module (e.g. after the SW-Module has restarted).
+// Values are sent with the proxy unlocked. Values removed or changed while the replay was in progress
+// are corrected afterwards, so that the replay cannot resurrect a removed value.
+func (m *moduleProxy) replay(ctx context.Context) error {
+	m.Lock()
+	if !m.available || len(m.values) == 0 {
+		m.Unlock()
+		return nil
+	}
+	cfgClient := m.cfgClient
+	replayed := make(map[string]proto.Message, len(m.values))
+	for key, value := range m.values {
+		replayed[key] = value
+	}
+	m.Unlock()
+
+	txn := cfgClient.ChangeRequest()
+	for _, value := range replayed {
+		txn.Update(value)
+	}
+	if err := txn.Send(ctx); err != nil {
+		return err
+	}
+
+	m.Lock()
+	txn = cfgClient.ChangeRequest()
+	var corrections int
+	for key, value := range replayed {
+		current, exists := m.values[key]
+		if !exists {
+			txn.Delete(value)
+			corrections++
+		} else if current != value {
+			txn.Update(current)
+			corrections++
+		}
+	}
+	m.Unlock()
+	if corrections == 0 {
+		return nil
+	}
+	return txn.Send(ctx)
+}
+
+// The function initializes proxy descriptor for every config model (and state descriptor for every state model)
+// exposed by the given CNF (unless it was already initialized before the CNF restarted).
 func (p *Plugin) initCnfProxy(swMod swModule) error {
 	// create descriptor for each model
 	for _, cnfModel := range swMod.cnfModels {
@@ -42,11 +198,17 @@ func (p *Plugin) initCnfProxy(swMod swModule) error {
 			return err
 		}
 		descrName := swMod.cnfMsLabel + "-" + cnfModel.info.ProtoName
+		swMod.proxy.Lock()
+		_, registered := swMod.proxy.descriptors[descrName]
+		swMod.proxy.descriptors[descrName] = struct{}{}
+		swMod.proxy.Unlock()
+		if registered {
+			continue
+		}
 		proxyDescr := &proxyDescriptor{
 			log:        p.Log.NewLogger(descrName),
 			puntMgr:    p.PuntMgr,
-			cnfClient:  swMod.cnfClient,
-			cfgClient:  swMod.cfgClient,
+			module:     swMod.proxy,
 			cnfMsLabel: swMod.cnfMsLabel,
 			model:      model,
 			withPunt:   cnfModel.withPunt,
@@ -64,17 +226,27 @@ func (p *Plugin) initCnfProxy(swMod swModule) error {
 			Update:             proxyDescr.Update,
 			UpdateWithRecreate: proxyDescr.UpdateWithRecreate,
 			Dependencies:       proxyDescr.Dependencies,
+			IsRetriableFailure: isRetriableFailure,
 		}
 		if cnfModel.withRetrieve {
 			descr.Retrieve = proxyDescr.Retrieve
 		}
+		if cnfModel.withValidate {
+			descr.Validate = proxyDescr.Validate
+		}
 		err = p.KVScheduler.RegisterKVDescriptor(descr)
 		if err != nil {
+			swMod.proxy.Lock()
+			delete(swMod.proxy.descriptors, descrName)
+			swMod.proxy.Unlock()
 			p.Log.Errorf("failed to register proxy descriptor for model %s: %v", spec.ModelName(), err)
 			return err
 		}
+		if swMod.proxy.batch != nil && !cnfModel.withPunt && !cnfModel.withDeps {
+			swMod.proxy.batch.addModel(model)
+		}
 	}
-	return nil
+	return p.initStateProxy(swMod)
 }
 
 // punt requests for a single key, identified in the map by labels
@@ -84,8 +256,7 @@ type puntReqsForKey map[string]*puntmgr.PuntRequest
 type proxyDescriptor struct {
 	log        logging.Logger
 	puntMgr    PuntManagerAPI
-	cnfClient  pb.CnfDiscoveryClient
-	cfgClient  client.GenericClient
+	module     *moduleProxy
 	cnfMsLabel string
 	model      models.KnownModel
 	withPunt   bool
@@ -95,30 +266,85 @@ type proxyDescriptor struct {
 
 // Create operation is proxied over the gRPC client.
 func (p *proxyDescriptor) Create(key string, value proto.Message) (metadata kvs.Metadata, err error) {
+	if _, _, available := p.module.clients(); !available {
+		return nil, p.unavailableErr()
+	}
 	if p.withPunt {
 		// establish packet punting before creating the configuration item
-		puntReqs, err := p.getPuntReqs(value)
+		puntReqs, err := p.getPuntReqs(key, value)
 		if err != nil {
 			p.log.Error(err)
 			return nil, err
 		}
 		p.punts[key] = puntReqs
-		for _, puntReq := range puntReqs {
-			err = p.puntMgr.AddPunt(p.cnfMsLabel, key, puntReq)
-			if err != nil {
-				err = fmt.Errorf("AddPunt failed (%s|%s|%s): %w",
-					p.cnfMsLabel, key, puntReq.Label, err)
-				p.log.Error(err)
-				return nil, err
-			}
+		if err = p.addPunts(key, puntReqs); err != nil {
+			return nil, err
 		}
 	}
-	ctx := context.Background()
-	err = p.cfgClient.ChangeRequest().Update(value).Send(ctx)
+	p.module.setValue(key, value)
+	err = p.sendChange(key, value, false)
+	if err != nil {
+		// ask the SW-Module again when the operation is retried
+		p.module.uncache(key)
+	}
 	return nil, err
 }
 
-func (p *proxyDescriptor) getPuntReqs(value proto.Message) (puntReqs puntReqsForKey, err error) {
+// sendChange proxies the change of the value to the SW-Module, unless the change was already applied
+// in a batch ahead of the transaction, in which case the result of the change is returned.
+func (p *proxyDescriptor) sendChange(key string, value proto.Message, remove bool) error {
+	if p.module.batch != nil {
+		if applied, err := p.module.batch.takeResult(key, value, remove); applied {
+			return err
+		}
+	}
+	_, cfgClient, available := p.module.clients()
+	if !available {
+		return p.unavailableErr()
+	}
+	return p.module.call("SetConfig", func(ctx context.Context) error {
+		txn := cfgClient.ChangeRequest()
+		if remove {
+			txn.Delete(value)
+		} else {
+			txn.Update(value)
+		}
+		return txn.Send(ctx)
+	})
+}
+
+// unavailableErr returns error for operations which cannot be proxied to the departed SW-Module.
+func (p *proxyDescriptor) unavailableErr() error {
+	return fmt.Errorf("%w: %s", ErrCnfNotLoaded, p.cnfMsLabel)
+}
+
+// addPunts requests all the given punts from the Punt Manager (adding already existing punt is a no-op).
+// All punts are requested even if some fail, the first error is returned.
+// With Punt Manager configured for synchronous punt configuration, the error is also returned for punts that are
+// not created yet, causing the operation to be retried by KVScheduler.
+func (p *proxyDescriptor) addPunts(key string, puntReqs puntReqsForKey) (err error) {
+	for _, puntReq := range puntReqs {
+		if puntErr := p.puntMgr.AddPunt(p.cnfMsLabel, key, puntReq); puntErr != nil {
+			puntErr = fmt.Errorf("AddPunt failed (%s|%s|%s): %w",
+				p.cnfMsLabel, key, puntReq.Label, puntErr)
+			p.log.Error(puntErr)
+			if err == nil {
+				err = puntErr
+			}
+		}
+	}
+	return err
+}
+
+// getPuntReqs returns punt requests of the value, obtained from the SW-Module unless they are cached.
+func (p *proxyDescriptor) getPuntReqs(key string, value proto.Message) (puntReqs puntReqsForKey, err error) {
+	valueHash, hashErr := hashValue(value)
+	if hashErr == nil {
+		if info := p.module.cachedInfo(key, valueHash); info != nil && info.puntReqs != nil {
+			return info.puntReqs, nil
+		}
+	}
+	generation := p.module.cacheGeneration()
 	puntReqs = make(puntReqsForKey)
 	item, err := models.MarshalItem(value)
 	if err != nil {
@@ -126,42 +352,112 @@ func (p *proxyDescriptor) getPuntReqs(value proto.Message) (puntReqs puntReqsFor
 		p.log.Error(err)
 		return nil, err
 	}
-	ctx := context.Background()
-	reqs, err := p.cnfClient.GetPuntRequests(ctx, item)
+	cnfClient, _, available := p.module.clients()
+	if !available {
+		return nil, p.unavailableErr()
+	}
+	var reqs *puntmgr.PuntRequests
+	err = p.module.call("GetPuntRequests", func(ctx context.Context) (err error) {
+		reqs, err = cnfClient.GetPuntRequests(ctx, item)
+		return err
+	})
 	if err != nil {
 		err = fmt.Errorf("GetPuntRequests failed: %w", err)
 		p.log.Error(err)
 		return nil, err
 	}
 	for _, puntReq := range reqs.PuntRequests {
+		if err = p.module.checkPunt(puntReq); err != nil {
+			err = fmt.Errorf("punt %s requested for item %s cannot be used: %w", puntReq.Label, item.GetId(), err)
+			p.log.Error(err)
+			return nil, err
+		}
 		puntReqs[puntReq.Label] = puntReq
 	}
+	if hashErr == nil {
+		p.module.updateCache(key, valueHash, generation, func(info *itemInfo) {
+			info.puntReqs = puntReqs
+		})
+	}
 	return puntReqs, nil
 }
 
-func (p *proxyDescriptor) getDependencies(value proto.Message) (deps []*pb.ConfigItemDependency, err error) {
+// getDependencies returns dependencies of the value, obtained from the SW-Module unless they are cached.
+func (p *proxyDescriptor) getDependencies(key string, value proto.Message) (deps []*pb.ConfigItemDependency, err error) {
+	valueHash, hashErr := hashValue(value)
+	if hashErr == nil {
+		if info := p.module.cachedInfo(key, valueHash); info != nil && info.withDeps {
+			return info.deps, nil
+		}
+	}
+	generation := p.module.cacheGeneration()
 	item, err := models.MarshalItem(value)
 	if err != nil {
 		err = fmt.Errorf("failed to marshal proto message into Item: %w", err)
 		p.log.Error(err)
 		return nil, err
 	}
-	ctx := context.Background()
-	resp, err := p.cnfClient.GetItemDependencies(ctx, item)
+	cnfClient, _, available := p.module.clients()
+	if !available {
+		return nil, p.unavailableErr()
+	}
+	var resp *pb.GetDependenciesResp
+	err = p.module.call("GetItemDependencies", func(ctx context.Context) (err error) {
+		resp, err = cnfClient.GetItemDependencies(ctx, item)
+		return err
+	})
 	if err != nil {
 		err = fmt.Errorf("GetItemDependencies failed: %w", err)
 		p.log.Error(err)
 		return nil, err
 	}
 	deps = resp.Dependencies
+	if hashErr == nil {
+		p.module.updateCache(key, valueHash, generation, func(info *itemInfo) {
+			info.deps = deps
+			info.withDeps = true
+		})
+	}
 	return deps, nil
 }
 
-// Delete operation is proxied over the gRPC client.
-func (p *proxyDescriptor) Delete(key string, value proto.Message, metadata kvs.Metadata) (err error) {
-	err = p.cfgClient.ChangeRequest().Delete(value).Send(context.Background())
+// Validate operation is proxied over the gRPC client. Validation failure reported by the SW-Module
+// is returned as kvs.InvalidValueError. If the validation cannot be performed (e.g. the SW-Module
+// is not available), the value is accepted and it is left to the SW-Module to refuse it when applied.
+func (p *proxyDescriptor) Validate(key string, value proto.Message) error {
+	item, err := models.MarshalItem(value)
 	if err != nil {
+		return kvs.NewInvalidValueError(fmt.Errorf("failed to marshal proto message into Item: %w", err))
+	}
+	cnfClient, _, available := p.module.clients()
+	if !available {
+		return nil
+	}
+	var resp *pb.ValidateItemResp
+	err = p.module.call("ValidateItem", func(ctx context.Context) (err error) {
+		resp, err = cnfClient.ValidateItem(ctx, item)
 		return err
+	})
+	if err != nil {
+		p.log.Warnf("ValidateItem failed for %s (value is not validated): %v", key, err)
+		return nil
+	}
+	if resp.GetError() != "" {
+		return kvs.NewInvalidValueError(errors.New(resp.GetError()), resp.GetInvalidFields()...)
+	}
+	return nil
+}
+
+// Delete operation is proxied over the gRPC client.
+// For departed SW-Module only the punts are removed.
+func (p *proxyDescriptor) Delete(key string, value proto.Message, metadata kvs.Metadata) (err error) {
+	p.module.setValue(key, nil)
+	p.module.uncache(key)
+	if _, _, available := p.module.clients(); available {
+		err = p.sendChange(key, value, true)
+		if err != nil {
+			return err
+		}
 	}
 	if puntReqs := p.punts[key]; puntReqs != nil {
 		for _, puntReq := range puntReqs {
@@ -181,6 +477,9 @@ func (p *proxyDescriptor) Delete(key string, value proto.Message, metadata kvs.M
 // Update operation is proxied over the gRPC client.
 func (p *proxyDescriptor) Update(key string, oldValue, newValue proto.Message, oldMetadata kvs.Metadata) (
 	newMetadata kvs.Metadata, err error) {
+	if _, _, available := p.module.clients(); !available {
+		return nil, p.unavailableErr()
+	}
 
 	var (
 		newPuntReqs  puntReqsForKey
@@ -188,25 +487,28 @@ func (p *proxyDescriptor) Update(key string, oldValue, newValue proto.Message, o
 	)
 	// add new punt configuration
 	if p.withPunt {
-		newPuntReqs, err = p.getPuntReqs(newValue)
+		newPuntReqs, err = p.getPuntReqs(key, newValue)
 		if err != nil {
 			return nil, err
 		}
 		prevPuntReqs := p.punts[key]
 		addPR, delPR, _ = p.diffPuntReqs(prevPuntReqs, newPuntReqs)
+		addReqs := make(puntReqsForKey)
 		for _, puntReq := range addPR {
-			err = p.puntMgr.AddPunt(p.cnfMsLabel, key, puntReq)
-			if err != nil {
-				err = fmt.Errorf("AddPunt failed (%s|%s|%s): %w",
-					p.cnfMsLabel, key, puntReq.Label, err)
-				p.log.Error(err)
-				return nil, err
-			}
+			addReqs[puntReq.Label] = puntReq
+		}
+		if err = p.addPunts(key, addReqs); err != nil {
+			return nil, err
 		}
 	}
 
 	// update configuration over gRPC
-	err = p.cfgClient.ChangeRequest().Update(newValue).Send(context.Background())
+	p.module.setValue(key, newValue)
+	err = p.sendChange(key, newValue, false)
+	if err != nil {
+		// ask the SW-Module again when the operation is retried
+		p.module.uncache(key)
+	}
 
 	// delete obsolete punt configuration
 	if p.withPunt {
@@ -229,7 +531,7 @@ func (p *proxyDescriptor) UpdateWithRecreate(key string, oldValue, newValue prot
 	if !p.withPunt {
 		return false
 	}
-	newPuntReqs, err := p.getPuntReqs(newValue)
+	newPuntReqs, err := p.getPuntReqs(key, newValue)
 	if err != nil {
 		return true
 	}
@@ -276,8 +578,15 @@ func (p *proxyDescriptor) diffPuntReqs(prev, new puntReqsForKey) (add, del, upda
 }
 
 // Retrieve operation is proxied over the gRPC client.
+// Punt requests and dependencies of the values expected by NB are obtained from the SW-Module in batches,
+// so that the resync does not need a separate round-trip for each value.
 func (p *proxyDescriptor) Retrieve(correlate []kvs.KVWithMetadata) (retrieved []kvs.KVWithMetadata, err error) {
-	resp, err := p.cfgClient.DumpState()
+	if _, _, available := p.module.clients(); !available {
+		// nothing is configured in a departed SW-Module
+		return nil, nil
+	}
+	p.prefetch(correlate)
+	resp, err := p.module.dumpState()
 	if err != nil {
 		return nil, err
 	}
@@ -307,20 +616,28 @@ func (p *proxyDescriptor) Retrieve(correlate []kvs.KVWithMetadata) (retrieved []
 
 // Dependencies return the list of VPP interfaces that are mentioned in the punt configuration
 // + any additional dependencies requested through the model registration.
+// Every proxied value also depends on the availability of the SW-Module.
 func (p *proxyDescriptor) Dependencies(key string, value proto.Message) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: "cnf-module-available",
+		Key:   ModuleAvailabilityKey(p.cnfMsLabel),
+	})
+	if _, _, available := p.module.clients(); !available {
+		return deps
+	}
 	if p.withPunt {
-		puntReqs, err := p.getPuntReqs(value)
+		puntReqs, err := p.getPuntReqs(key, value)
 		if err != nil {
-			return nil
+			return deps
 		}
 		for _, puntReq := range puntReqs {
 			deps = append(deps, p.puntMgr.GetPuntDependencies(p.cnfMsLabel, puntReq)...)
 		}
 	}
 	if p.withDeps {
-		extraDeps, err := p.getDependencies(value)
+		extraDeps, err := p.getDependencies(key, value)
 		if err != nil {
-			return nil
+			return deps
 		}
 		for _, dep := range extraDeps {
 			switch v := dep.Dep.(type) {
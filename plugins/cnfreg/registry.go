@@ -0,0 +1,390 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"go.ligato.io/vpp-agent/v3/client/remoteclient"
+
+	pb "go.pantheon.tech/stonework/proto/cnfreg"
+)
+
+const (
+	// source of SW-Modules registered through the CnfRegistry service is this prefix + microservice label
+	registrationSourcePrefix = "registration:"
+
+	// how often SW-Module retries failed registration
+	registrationRetryPeriod = 2 * time.Second
+	// timeout for RegisterCnf (loading of a restarted SW-Module includes its resync)
+	registrationTimeout = moduleResyncTimeout + 30*time.Second
+	// timeout for DeregisterCnf called by SW-Module on shutdown
+	deregistrationTimeout = 3 * time.Second
+
+	// gRPC metadata of RegisterCnf identifying the process of the SW-Module
+	// (registration repeated by the same process is not a restart), DeregisterCnf and Heartbeat
+	// are accepted only with the identifier of the process which has registered the SW-Module
+	registrationInstanceMD = "stonework-instance"
+)
+
+func registrationSource(cnfMsLabel string) string {
+	return registrationSourcePrefix + cnfMsLabel
+}
+
+// RegisterCnf is served by the CNFRegistry of StoneWork. The calling SW-Module is loaded the same way
+// as if it was discovered through a pid file, except that DiscoverCnf is not called (the request carries
+// the same information). Registration repeated by the already loaded process of the SW-Module (e.g. retried
+// after the deadline of the previous attempt) only extends the registration. The process is identified
+// by the instance identifier sent as gRPC metadata, which binds DeregisterCnf and Heartbeat to the registration.
+func (p *Plugin) RegisterCnf(ctx context.Context, req *pb.RegisterCnfReq) (*pb.RegisterCnfResp, error) {
+	p.Log.Debugf("Handling RegisterCnf(%+v)", req)
+	cnfMsLabel := req.GetCnf().GetCnfMsLabel()
+	if cnfMsLabel == "" || req.GetGrpcPort() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "registration requires microservice label and gRPC port")
+	}
+	if err := p.verifyModulePeer(ctx, cnfMsLabel); err != nil {
+		return nil, err
+	}
+	pf := PidFile{
+		Pid:       int(req.GetPid()),
+		MsLabel:   cnfMsLabel,
+		IpAddress: req.GetIpAddress(),
+		GrpcPort:  int(req.GetGrpcPort()),
+		HttpPort:  int(req.GetHttpPort()),
+	}
+	if pf.IpAddress == "" {
+		pf.IpAddress = peerHost(ctx)
+	}
+	instance := registrationInstance(ctx)
+	if instance == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "registration requires instance identifier (%s metadata)",
+			registrationInstanceMD)
+	}
+
+	unlock := p.lockModule(cnfMsLabel)
+	defer unlock()
+	if loaded, isLoaded := p.sw.modules.Get(cnfMsLabel); isLoaded &&
+		loaded.source == registrationSource(cnfMsLabel) && loaded.instance == instance {
+		p.sw.registrations.Set(cnfMsLabel, time.Now().Add(p.config.RegistrationTTL))
+		p.Log.Infof("SW-Module %s has repeated registration (pid %d)", cnfMsLabel, loaded.pid)
+		return p.registrationResp(), nil
+	}
+	swMod, err := p.dialSwMod(pf)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to connect to SW-Module %s: %v", cnfMsLabel, err)
+	}
+	if err = p.loadCnfModels(&swMod, req.GetCnf()); err != nil {
+		_ = swMod.grpcConn.Close()
+		if errors.Is(err, ErrIncompatibleApi) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to obtain CNF models of SW-Module %s: %v",
+			cnfMsLabel, err)
+	}
+	swMod.source = registrationSource(cnfMsLabel)
+	swMod.instance = instance
+	p.sw.registrations.Set(cnfMsLabel, time.Now().Add(p.config.RegistrationTTL))
+	p.loadModule(swMod)
+	// (loading of restarted SW-Module may take a while, the registration is valid from now on)
+	p.sw.registrations.Set(cnfMsLabel, time.Now().Add(p.config.RegistrationTTL))
+	p.Log.Infof("SW-Module %s has registered (pid %d)", cnfMsLabel, swMod.pid)
+	return p.registrationResp(), nil
+}
+
+// registrationResp returns response to RegisterCnf.
+func (p *Plugin) registrationResp() *pb.RegisterCnfResp {
+	resp := &pb.RegisterCnfResp{
+		HeartbeatIntervalMs: uint32(p.heartbeatInterval().Milliseconds()),
+		SwCapabilities:      p.capabilities(),
+	}
+	if p.HTTPPlugin != nil {
+		resp.SwHttpPort = uint32(p.HTTPPlugin.GetPort())
+	}
+	return resp
+}
+
+// registrationInstance returns identifier of the SW-Module process sent with a call of the CnfRegistry
+// service (empty if not sent).
+func registrationInstance(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(registrationInstanceMD); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// DeregisterCnf is served by the CNFRegistry of StoneWork and removes the calling SW-Module
+// (if it was loaded through RegisterCnf by the same process).
+func (p *Plugin) DeregisterCnf(ctx context.Context, req *pb.DeregisterCnfReq) (*pb.DeregisterCnfResp, error) {
+	p.Log.Debugf("Handling DeregisterCnf(%+v)", req)
+	if err := p.verifyModulePeer(ctx, req.GetCnfMsLabel()); err != nil {
+		return nil, err
+	}
+	swMod, registered, err := p.registeredModule(ctx, req.GetCnfMsLabel())
+	if err != nil || !registered {
+		return &pb.DeregisterCnfResp{}, err
+	}
+	p.removeModule(swMod, "SW-Module has deregistered")
+	p.sw.registrations.Del(req.GetCnfMsLabel())
+	return &pb.DeregisterCnfResp{}, nil
+}
+
+// Heartbeat is served by the CNFRegistry of StoneWork and extends the registration of the calling SW-Module.
+func (p *Plugin) Heartbeat(ctx context.Context, req *pb.HeartbeatReq) (*pb.HeartbeatResp, error) {
+	if err := p.verifyModulePeer(ctx, req.GetCnfMsLabel()); err != nil {
+		return nil, err
+	}
+	resp := &pb.HeartbeatResp{}
+	_, registered, err := p.registeredModule(ctx, req.GetCnfMsLabel())
+	if err != nil {
+		return nil, err
+	}
+	if !registered {
+		return resp, nil
+	}
+	p.sw.registrations.Set(req.GetCnfMsLabel(), time.Now().Add(p.config.RegistrationTTL))
+	resp.Registered = true
+	return resp, nil
+}
+
+// registeredModule returns the SW-Module if it is loaded through RegisterCnf. The caller has to present
+// the instance identifier of the process which has registered the SW-Module, otherwise PermissionDenied
+// is returned (e.g. stale process cannot deregister or keep alive its restarted successor).
+func (p *Plugin) registeredModule(ctx context.Context, cnfMsLabel string) (swMod swModule, registered bool,
+	err error) {
+	swMod, loaded := p.sw.modules.Get(cnfMsLabel)
+	if !loaded || swMod.source != registrationSource(cnfMsLabel) {
+		return swMod, false, nil
+	}
+	if registrationInstance(ctx) != swMod.instance {
+		return swMod, false, status.Errorf(codes.PermissionDenied,
+			"SW-Module %s is registered by another instance", cnfMsLabel)
+	}
+	return swMod, true, nil
+}
+
+// registrationExpired returns true if the SW-Module was loaded through RegisterCnf and has not sent
+// heartbeat within the registration TTL.
+func (p *Plugin) registrationExpired(swMod swModule, now time.Time) bool {
+	if !strings.HasPrefix(swMod.source, registrationSourcePrefix) {
+		return false
+	}
+	expires, registered := p.sw.registrations.Get(swMod.cnfMsLabel)
+	return !registered || now.After(expires)
+}
+
+// heartbeatInterval returns how often should registered SW-Modules send heartbeats.
+// Several heartbeats may get lost before the registration expires.
+func (p *Plugin) heartbeatInterval() time.Duration {
+	return p.config.RegistrationTTL / 3
+}
+
+// newRegistrationInstance returns random identifier of this process (PID is not unique across restarts
+// of containers).
+func newRegistrationInstance() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate registration instance: %w", err)
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// peerHost returns IP address of the gRPC client.
+func peerHost(ctx context.Context) string {
+	pr, ok := peer.FromContext(ctx)
+	if !ok || pr.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(pr.Addr.String())
+	if err != nil {
+		return pr.Addr.String()
+	}
+	return host
+}
+
+// startRegistration is used by SW-Module configured with the address of StoneWork (instead of writing
+// pid file). Connection with StoneWork is established in the background and the SW-Module keeps
+// registering itself and sending heartbeats until the plugin is closed.
+func (p *Plugin) startRegistration() error {
+	host, port, err := net.SplitHostPort(p.config.StoneWorkAddress)
+	if err != nil {
+		return fmt.Errorf("invalid address of StoneWork %q: %w", p.config.StoneWorkAddress, err)
+	}
+	grpcPort, err := strconv.Atoi(port)
+	if err != nil {
+		return fmt.Errorf("invalid gRPC port of StoneWork %q: %w", port, err)
+	}
+	instance, err := newRegistrationInstance()
+	if err != nil {
+		return err
+	}
+	var tlsIdentity string
+	if p.tls != nil {
+		tlsIdentity = p.config.TLS.StoneWorkIdentity
+	}
+	conn, err := grpc.Dial(p.config.StoneWorkAddress, p.tls.dialOption(tlsIdentity))
+	if err != nil {
+		return err
+	}
+	cfgClient, err := remoteclient.NewClientGRPC(conn)
+	if err != nil {
+		return err
+	}
+
+	p.swMod.Lock()
+	p.swMod.swIpAddress = host
+	p.swMod.swGrpcPort = grpcPort
+	p.swMod.swGrpcConn = conn
+	p.swMod.swCfgClient = cfgClient
+	p.swMod.registry = pb.NewCnfRegistryClient(conn)
+	p.swMod.instance = instance
+	p.swMod.registrationDone = make(chan struct{})
+	p.swMod.Unlock()
+
+	go p.keepRegistered(p.swMod.registrationDone)
+	return nil
+}
+
+// keepRegistered registers SW-Module with StoneWork and keeps the registration alive by heartbeats.
+// Registration is repeated whenever StoneWork reports that the SW-Module is not registered
+// (e.g. the registration has expired or StoneWork has restarted).
+func (p *Plugin) keepRegistered(done <-chan struct{}) {
+	for {
+		interval, err := p.registerCnf()
+		if err != nil {
+			p.Log.Warnf("failed to register with StoneWork %s (retry in %v): %v",
+				p.config.StoneWorkAddress, registrationRetryPeriod, err)
+			select {
+			case <-done:
+				return
+			case <-time.After(registrationRetryPeriod):
+			}
+			continue
+		}
+		p.Log.Infof("Registered with StoneWork %s", p.config.StoneWorkAddress)
+		if !p.sendHeartbeats(interval, done) {
+			return
+		}
+	}
+}
+
+// registerCnf calls RegisterCnf with the description of this SW-Module and returns the heartbeat interval
+// requested by StoneWork.
+func (p *Plugin) registerCnf() (heartbeatInterval time.Duration, err error) {
+	p.swMod.Lock()
+	req := &pb.RegisterCnfReq{
+		Pid:      int32(os.Getpid()),
+		GrpcPort: uint32(p.GetGrpcPort()),
+		HttpPort: uint32(p.GetHttpPort()),
+		Cnf: &pb.DiscoverCnfResp{
+			CnfMsLabel:   p.ServiceLabel.GetAgentLabel(),
+			ConfigModels: p.exposedConfigModels(),
+			StateModels:  p.exposedStateModels(),
+			GrpcServices: p.swMod.grpcServices,
+			Capabilities: p.capabilities(),
+		},
+	}
+	if p.ipAddress != nil || p.config.AdvertisedAddress != "" {
+		// otherwise StoneWork uses the address from which the request was received
+		req.IpAddress = p.advertisedAddress()
+	}
+	p.swMod.discovered = true
+	p.swMod.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), registrationTimeout)
+	defer cancel()
+	resp, err := p.swMod.registry.RegisterCnf(p.withRegistrationInstance(ctx), req)
+	if err != nil {
+		return 0, err
+	}
+	p.swMod.Lock()
+	p.swMod.swHttpPort = int(resp.GetSwHttpPort())
+	p.swMod.swCaps = resp.GetSwCapabilities()
+	p.swMod.Unlock()
+	heartbeatInterval = time.Duration(resp.GetHeartbeatIntervalMs()) * time.Millisecond
+	if heartbeatInterval <= 0 {
+		heartbeatInterval = defaultRegistrationTTL / 3
+	}
+	return heartbeatInterval, nil
+}
+
+// sendHeartbeats periodically sends heartbeats to StoneWork. Returns true if the SW-Module should register
+// again, false if the plugin was closed.
+func (p *Plugin) sendHeartbeats(interval time.Duration, done <-chan struct{}) bool {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return false
+		case <-ticker.C:
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		resp, err := p.swMod.registry.Heartbeat(p.withRegistrationInstance(ctx), &pb.HeartbeatReq{
+			CnfMsLabel: p.ServiceLabel.GetAgentLabel(),
+		})
+		cancel()
+		if err != nil {
+			// StoneWork expires the registration if this persists
+			p.Log.Warnf("failed to send heartbeat to StoneWork: %v", err)
+			continue
+		}
+		if !resp.GetRegistered() {
+			p.Log.Warn("SW-Module is no longer registered with StoneWork, registering again")
+			return true
+		}
+	}
+}
+
+// stopRegistration stops sending heartbeats and deregisters SW-Module from StoneWork.
+func (p *Plugin) stopRegistration() {
+	if p.swMod.registrationDone == nil {
+		return
+	}
+	close(p.swMod.registrationDone)
+	ctx, cancel := context.WithTimeout(context.Background(), deregistrationTimeout)
+	defer cancel()
+	_, err := p.swMod.registry.DeregisterCnf(p.withRegistrationInstance(ctx), &pb.DeregisterCnfReq{
+		CnfMsLabel: p.ServiceLabel.GetAgentLabel(),
+	})
+	if err != nil {
+		p.Log.Warnf("failed to deregister from StoneWork: %v", err)
+	}
+}
+
+// withRegistrationInstance attaches identifier of this process to a call of the CnfRegistry service.
+func (p *Plugin) withRegistrationInstance(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, registrationInstanceMD, p.swMod.instance)
+}
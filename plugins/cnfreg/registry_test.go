@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.pantheon.tech/stonework/pkg/conc"
+	pb "go.pantheon.tech/stonework/proto/cnfreg"
+)
+
+func TestRegistrationBoundToInstance(t *testing.T) {
+	RegisterTestingT(t)
+	p := testDiscoveryPlugin()
+	p.config = &Config{RegistrationTTL: time.Minute}
+	p.sw.modules = conc.NewMap[string, swModule]()
+	p.sw.registrations = conc.NewMap[string, time.Time]()
+	p.sw.modules.Set("bgp", swModule{
+		cnfMsLabel: "bgp",
+		source:     registrationSource("bgp"),
+		instance:   "instance-1",
+	})
+	p.sw.modules.Set("ospf", swModule{
+		cnfMsLabel: "ospf",
+		source:     "/run/stonework/discovery/ospf.pid",
+	})
+	withInstance := func(instance string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(registrationInstanceMD, instance))
+	}
+
+	// heartbeat of the registered instance extends the registration
+	resp, err := p.Heartbeat(withInstance("instance-1"), &pb.HeartbeatReq{CnfMsLabel: "bgp"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.GetRegistered()).To(BeTrue())
+	_, registered := p.sw.registrations.Get("bgp")
+	Expect(registered).To(BeTrue())
+
+	// other instances are rejected
+	for _, ctx := range []context.Context{withInstance("instance-2"), context.Background()} {
+		_, err = p.Heartbeat(ctx, &pb.HeartbeatReq{CnfMsLabel: "bgp"})
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+		_, err = p.DeregisterCnf(ctx, &pb.DeregisterCnfReq{CnfMsLabel: "bgp"})
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	}
+	_, stillLoaded := p.sw.modules.Get("bgp")
+	Expect(stillLoaded).To(BeTrue())
+
+	// SW-Modules not loaded through the registration are not affected
+	resp, err = p.Heartbeat(withInstance("instance-1"), &pb.HeartbeatReq{CnfMsLabel: "ospf"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.GetRegistered()).To(BeFalse())
+	_, err = p.DeregisterCnf(withInstance("instance-1"), &pb.DeregisterCnfReq{CnfMsLabel: "ospf"})
+	Expect(err).ToNot(HaveOccurred())
+	_, stillLoaded = p.sw.modules.Get("ospf")
+	Expect(stillLoaded).To(BeTrue())
+
+	// registration without instance identifier is refused
+	_, err = p.RegisterCnf(context.Background(), &pb.RegisterCnfReq{
+		GrpcPort: 9111,
+		Cnf:      &pb.DiscoverCnfResp{CnfMsLabel: "isis"},
+	})
+	Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
@@ -35,6 +35,13 @@ type Info struct {
 	GRPCPort      int
 	HTTPPort      int
 	GRPCConnState connectivity.State
+	APIVersion    uint32
+	// Compatibility of SW-Module with StoneWork (compatible, degraded, legacy or rejected)
+	// and the reasons if not fully compatible.
+	Compatibility       string   `json:",omitempty"`
+	CompatibilityIssues []string `json:",omitempty"`
+	// SW-Module has repeatedly not responded in time and its calls are suspended.
+	Unhealthy bool `json:",omitempty"`
 }
 
 func (p *Plugin) registerHandlers(handlers rest.HTTPHandlers) {
@@ -52,30 +59,45 @@ func (p *Plugin) statusHandler(formatter *render.Render) http.HandlerFunc {
 		var infos []*Info
 
 		swInfo := &Info{
-			PID:      os.Getpid(),
-			MsLabel:  p.ServiceLabel.GetAgentLabel(),
-			CnfMode:  pb.CnfMode_STONEWORK,
-			IPAddr:   p.ipAddress.String(),
-			GRPCPort: p.GRPCPlugin.GetPort(),
-			HTTPPort: p.HTTPPlugin.GetPort(),
+			PID:        os.Getpid(),
+			MsLabel:    p.ServiceLabel.GetAgentLabel(),
+			CnfMode:    pb.CnfMode_STONEWORK,
+			IPAddr:     p.advertisedAddress(),
+			GRPCPort:   p.GRPCPlugin.GetPort(),
+			HTTPPort:   p.HTTPPlugin.GetPort(),
+			APIVersion: ApiVersion,
 		}
 		infos = append(infos, swInfo)
 
 		for kv := range p.sw.modules.Iter() {
-			swMod := kv.Val
-			swModInfo := &Info{
-				PID:           swMod.pid,
-				MsLabel:       swMod.cnfMsLabel,
-				CnfMode:       pb.CnfMode_STONEWORK_MODULE,
-				IPAddr:        swMod.ipAddress,
-				GRPCPort:      swMod.grpcPort,
-				HTTPPort:      swMod.httpPort,
-				GRPCConnState: swMod.grpcConn.GetState(),
-			}
-			infos = append(infos, swModInfo)
+			infos = append(infos, swModuleInfo(kv.Val))
+		}
+		for kv := range p.sw.rejected.Iter() {
+			infos = append(infos, swModuleInfo(kv.Val))
 		}
 		if err := formatter.JSON(w, http.StatusOK, infos); err != nil {
 			p.Log.Error(err)
 		}
 	}
 }
+
+func swModuleInfo(swMod swModule) *Info {
+	info := &Info{
+		PID:           swMod.pid,
+		MsLabel:       swMod.cnfMsLabel,
+		CnfMode:       pb.CnfMode_STONEWORK_MODULE,
+		IPAddr:        swMod.ipAddress,
+		GRPCPort:      swMod.grpcPort,
+		HTTPPort:      swMod.httpPort,
+		GRPCConnState: swMod.grpcConn.GetState(),
+	}
+	if swMod.compat != nil {
+		info.APIVersion = swMod.compat.apiVersion
+		info.Compatibility = swMod.compat.status
+		info.CompatibilityIssues = swMod.compat.issues
+	}
+	if swMod.proxy != nil {
+		info.Unhealthy = swMod.proxy.breaker.isOpen()
+	}
+	return info
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/client/remoteclient"
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+
+	pb "go.pantheon.tech/stonework/proto/cnfreg"
+)
+
+// class of models representing operational state in the model registry
+const stateModelClass = "metrics"
+
+// GetStateItems is served by CNFRegistry of a SW-Module CNF and returns the current operational state
+// of the given state model.
+func (p *Plugin) GetStateItems(ctx context.Context, req *pb.GetStateItemsReq) (resp *pb.GetStateItemsResp, err error) {
+	p.Log.Debugf("Handling GetStateItems(%+v)", req)
+	resp = &pb.GetStateItemsResp{}
+	if err = p.VerifySWPeer(ctx); err != nil {
+		return resp, err
+	}
+	p.swMod.Lock()
+	if !p.swMod.discovered {
+		p.swMod.Unlock()
+		return resp, errors.New("CNF has not been yet discovered, execute DiscoverCnf first")
+	}
+	var dump StateDumpClb
+	for _, expModel := range p.swMod.stateModels {
+		if expModel.model.ProtoName() == req.GetProtoName() {
+			dump = expModel.dump
+			break
+		}
+	}
+	p.swMod.Unlock()
+	if dump == nil {
+		return resp, fmt.Errorf("state model %s is not exposed", req.GetProtoName())
+	}
+
+	// the state is read without the lock, the callback may take a while
+	values, err := dump()
+	if err != nil {
+		return resp, fmt.Errorf("failed to read state of %s: %w", req.GetProtoName(), err)
+	}
+	for _, value := range values {
+		item, err := models.MarshalItem(value)
+		if err != nil {
+			return resp, fmt.Errorf("failed to marshal state item: %w", err)
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	return resp, nil
+}
+
+// loadStateModels finds state models exposed by the SW-Module.
+func (p *Plugin) loadStateModels(swMod *swModule, stateModels []*pb.DiscoverCnfResp_StateModel) error {
+	stateClient, err := remoteclient.NewClientGRPC(swMod.grpcConn,
+		remoteclient.UseRemoteRegistry(stateModelClass))
+	if err != nil {
+		return err
+	}
+	knownModels, err := stateClient.KnownModels(stateModelClass)
+	if err != nil {
+		return err
+	}
+	for _, stateModel := range stateModels {
+		var found bool
+		for _, model := range knownModels {
+			if model.ProtoName == stateModel.GetProtoName() {
+				swMod.stateModels = append(swMod.stateModels, model)
+				found = true
+				break
+			}
+		}
+		if !found {
+			p.Log.Warnf("failed to find model info for state proto message %s", stateModel.GetProtoName())
+		}
+	}
+	return nil
+}
+
+// initStateProxy initializes state descriptor for every state model exposed by the given CNF
+// (unless it was already initialized before the CNF restarted).
+func (p *Plugin) initStateProxy(swMod swModule) error {
+	for _, info := range swMod.stateModels {
+		spec := models.ToSpec(info.Spec)
+		model, err := models.GetModel(spec.ModelName())
+		if err != nil {
+			p.Log.Errorf("failed to get state model %s: %v", spec.ModelName(), err)
+			return err
+		}
+		descrName := swMod.cnfMsLabel + "-" + info.ProtoName
+		swMod.proxy.Lock()
+		_, registered := swMod.proxy.descriptors[descrName]
+		swMod.proxy.descriptors[descrName] = struct{}{}
+		swMod.proxy.Unlock()
+		if registered {
+			continue
+		}
+		stateDescr := &stateDescriptor{
+			log:    p.Log.NewLogger(descrName),
+			module: swMod.proxy,
+			model:  model,
+		}
+		err = p.KVScheduler.RegisterKVDescriptor(&kvs.KVDescriptor{
+			Name:          descrName,
+			KeySelector:   model.IsKeyValid,
+			ValueTypeName: model.ProtoName(),
+			KeyLabel:      model.StripKeyPrefix,
+			Retrieve:      stateDescr.Retrieve,
+		})
+		if err != nil {
+			swMod.proxy.Lock()
+			delete(swMod.proxy.descriptors, descrName)
+			swMod.proxy.Unlock()
+			p.Log.Errorf("failed to register state descriptor for model %s: %v", spec.ModelName(), err)
+			return err
+		}
+	}
+	return nil
+}
+
+// stateDescriptor makes operational state of a SW-Module available in StoneWork. It only implements Retrieve,
+// values are read from the SW-Module whenever the KVScheduler of StoneWork retrieves the state
+// (i.e. during resync and for dumps with the SB view) and are reported as obtained from SB.
+type stateDescriptor struct {
+	log    logging.Logger
+	module *moduleProxy
+	model  models.KnownModel
+}
+
+// Retrieve reads the state of the model from the SW-Module.
+func (d *stateDescriptor) Retrieve(correlate []kvs.KVWithMetadata) (retrieved []kvs.KVWithMetadata, err error) {
+	cnfClient, _, available := d.module.clients()
+	if !available {
+		// departed SW-Module has no state
+		return nil, nil
+	}
+	var resp *pb.GetStateItemsResp
+	err = d.module.call("GetStateItems", func(ctx context.Context) (err error) {
+		resp, err = cnfClient.GetStateItems(ctx, &pb.GetStateItemsReq{ProtoName: d.model.ProtoName()})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range resp.GetItems() {
+		key, err := models.GetKeyForItem(item)
+		if err != nil {
+			d.log.Warnf("failed to get key for a state item: %v", err)
+			continue
+		}
+		value, err := models.UnmarshalItem(item)
+		if err != nil {
+			d.log.Warnf("failed to unmarshal state item %s: %v", key, err)
+			continue
+		}
+		retrieved = append(retrieved, kvs.KVWithMetadata{
+			Key:    key,
+			Value:  value,
+			Origin: kvs.FromSB,
+		})
+	}
+	return retrieved, nil
+}
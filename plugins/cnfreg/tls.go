@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	grpc_plugin "go.ligato.io/cn-infra/v2/rpc/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// TLSConfig configures mutual TLS authentication between StoneWork and SW-Modules.
+// The same CA is used to verify certificates of both sides.
+type TLSConfig struct {
+	// CA certificate(s) in PEM format used to verify certificates of peers.
+	CAFile string `json:"ca-file"`
+	// Certificate and private key in PEM format presented to peers.
+	CertFile string `json:"cert-file"`
+	KeyFile  string `json:"key-file"`
+	// Identities expected in certificates of SW-Modules, key = microservice label (used by StoneWork).
+	// SW-Modules without an entry are only verified against the CA.
+	ModuleIdentities map[string]string `json:"module-identities"`
+	// Identity expected in the certificate of StoneWork (used by SW-Module). Empty = only verify against the CA.
+	StoneWorkIdentity string `json:"stonework-identity"`
+}
+
+// cnfTLS is loaded TLS configuration.
+type cnfTLS struct {
+	config *TLSConfig
+	roots  *x509.CertPool
+	cert   tls.Certificate
+}
+
+// loadTLS loads certificates configured for mutual TLS authentication (nil if TLS is not configured).
+func loadTLS(config *TLSConfig) (*cnfTLS, error) {
+	if config == nil || (config.CAFile == "" && config.CertFile == "" && config.KeyFile == "") {
+		return nil, nil
+	}
+	if config.CAFile == "" || config.CertFile == "" || config.KeyFile == "" {
+		return nil, errors.New("TLS requires CA, certificate and key to be configured")
+	}
+	caPEM, err := os.ReadFile(config.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %w", err)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no valid certificate found in CA file %s", config.CAFile)
+	}
+	cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate: %w", err)
+	}
+	return &cnfTLS{
+		config: config,
+		roots:  roots,
+		cert:   cert,
+	}, nil
+}
+
+// checkServerTLS returns error if the gRPC server is not configured to serve TLS and to require
+// client certificates (SW-Module with TLS configured must not accept unauthenticated callers).
+func checkServerTLS(config *grpc_plugin.Config) error {
+	if config == nil || config.Certfile == "" || config.Keyfile == "" {
+		return errors.New("TLS is configured but gRPC server does not serve TLS " +
+			"(configure cert-file and key-file in grpc.conf)")
+	}
+	if len(config.CAfiles) == 0 {
+		return errors.New("TLS is configured but gRPC server does not require client certificates " +
+			"(configure ca-files in grpc.conf)")
+	}
+	return nil
+}
+
+// dialOption returns transport credentials for connection with a peer with the given expected identity.
+// Peer certificate is verified against the CA and the identity (instead of the dialed address, which is
+// typically a raw IP address).
+func (t *cnfTLS) dialOption(identity string) grpc.DialOption {
+	if t == nil {
+		return grpc.WithTransportCredentials(insecure.NewCredentials())
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(t.clientConfig(identity)))
+}
+
+// clientConfig returns TLS configuration for connection with a peer with the given expected identity.
+func (t *cnfTLS) clientConfig(identity string) *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{t.cert},
+		MinVersion:   tls.VersionTLS12,
+		// verification is done by VerifyPeerCertificate
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return t.verifyServerCert(rawCerts, identity)
+		},
+	}
+}
+
+func (t *cnfTLS) verifyServerCert(rawCerts [][]byte, identity string) error {
+	if len(rawCerts) == 0 {
+		return errors.New("peer did not present any certificate")
+	}
+	var certs []*x509.Certificate
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse peer certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         t.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify peer certificate: %w", err)
+	}
+	return checkIdentity(certs[0], identity)
+}
+
+// checkIdentity checks that the certificate was issued for the given identity (DNS name or URI SAN,
+// or Common Name). Empty identity matches any certificate.
+func checkIdentity(cert *x509.Certificate, identity string) error {
+	if identity == "" {
+		return nil
+	}
+	if cert.Subject.CommonName == identity {
+		return nil
+	}
+	for _, name := range cert.DNSNames {
+		if name == identity {
+			return nil
+		}
+	}
+	for _, uri := range cert.URIs {
+		if uri.String() == identity {
+			return nil
+		}
+	}
+	return fmt.Errorf("peer certificate is not issued for %s", identity)
+}
+
+// VerifySWPeer checks that the caller of a gRPC method served by SW-Module is StoneWork.
+// With TLS configured, the (already CA-verified) client certificate must match the expected identity
+// of StoneWork. Without TLS any caller is accepted.
+func (p *Plugin) VerifySWPeer(ctx context.Context) error {
+	if p.tls == nil {
+		return nil
+	}
+	return verifyPeer(ctx, p.tls.config.StoneWorkIdentity)
+}
+
+// verifyModulePeer checks that the caller of a gRPC method served by StoneWork is the SW-Module
+// with the given microservice label (the same way as VerifySWPeer).
+func (p *Plugin) verifyModulePeer(ctx context.Context, cnfMsLabel string) error {
+	if p.tls == nil {
+		return nil
+	}
+	return verifyPeer(ctx, p.tls.config.ModuleIdentities[cnfMsLabel])
+}
+
+// verifyPeer checks that the gRPC client is authenticated with a certificate issued for the given identity.
+func verifyPeer(ctx context.Context, identity string) error {
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "unknown peer")
+	}
+	tlsInfo, ok := pr.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return status.Error(codes.Unauthenticated, "peer is not authenticated with a client certificate")
+	}
+	if err := checkIdentity(tlsInfo.State.VerifiedChains[0][0], identity); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
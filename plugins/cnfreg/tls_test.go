@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnfreg
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	grpc_plugin "go.ligato.io/cn-infra/v2/rpc/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testModuleIdentity    = "mock.stonework.local"
+	testStoneWorkIdentity = "stonework.stonework.local"
+)
+
+// testPKI is a CA with certificates issued for StoneWork and a SW-Module, written into a temporary directory.
+type testPKI struct {
+	dir    string
+	caKey  *ecdsa.PrivateKey
+	caCert *x509.Certificate
+	serial int64
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	pki := &testPKI{dir: t.TempDir()}
+	var err error
+	pki.caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &pki.caKey.PublicKey, pki.caKey)
+	Expect(err).ToNot(HaveOccurred())
+	pki.caCert, err = x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+	pki.writePEM("ca.pem", "CERTIFICATE", der)
+	pki.serial = 1
+	return pki
+}
+
+func (pki *testPKI) writePEM(name, blockType string, der []byte) string {
+	fpath := filepath.Join(pki.dir, name)
+	err := os.WriteFile(fpath, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600)
+	Expect(err).ToNot(HaveOccurred())
+	return fpath
+}
+
+// issue creates certificate and key for the given identity (usable both by server and client).
+func (pki *testPKI) issue(identity string) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	pki.serial++
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(pki.serial),
+		Subject:      pkix.Name{CommonName: identity},
+		DNSNames:     []string{identity},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, pki.caCert, &key.PublicKey, pki.caKey)
+	Expect(err).ToNot(HaveOccurred())
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	Expect(err).ToNot(HaveOccurred())
+	return pki.writePEM(identity+".pem", "CERTIFICATE", der), pki.writePEM(identity+".key", "EC PRIVATE KEY", keyDer)
+}
+
+func (pki *testPKI) tlsConfig(identity string, peerIdentity string) *TLSConfig {
+	certFile, keyFile := pki.issue(identity)
+	return &TLSConfig{
+		CAFile:            filepath.Join(pki.dir, "ca.pem"),
+		CertFile:          certFile,
+		KeyFile:           keyFile,
+		StoneWorkIdentity: peerIdentity,
+	}
+}
+
+// startTestModule starts gRPC server configured the way grpc.conf of SW-Module with TLS configures it
+// (cert-file, key-file, ca-files). The served method checks that the caller is StoneWork.
+func startTestModule(t *testing.T, config *TLSConfig) string {
+	moduleTLS, err := loadTLS(config)
+	Expect(err).ToNot(HaveOccurred())
+	p := &Plugin{tls: moduleTLS}
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{moduleTLS.cert},
+		ClientCAs:    moduleTLS.roots,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	})
+	server := grpc.NewServer(grpc.Creds(creds), grpc.UnaryInterceptor(
+		func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+			interface{}, error) {
+			if err := p.VerifySWPeer(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+// callTestModule calls the test SW-Module with the given dial option.
+func callTestModule(addr string, dialOpt grpc.DialOption) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, dialOpt)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func TestDialModuleWithClientCert(t *testing.T) {
+	RegisterTestingT(t)
+	pki := newTestPKI(t)
+	addr := startTestModule(t, pki.tlsConfig(testModuleIdentity, testStoneWorkIdentity))
+
+	swTLS, err := loadTLS(pki.tlsConfig(testStoneWorkIdentity, ""))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(callTestModule(addr, swTLS.dialOption(testModuleIdentity))).To(Succeed())
+
+	// StoneWork verifies the identity of the SW-Module
+	err = callTestModule(addr, swTLS.dialOption("other.stonework.local"))
+	Expect(status.Code(err)).To(Equal(codes.Unavailable))
+}
+
+func TestDialModuleWithoutClientCert(t *testing.T) {
+	RegisterTestingT(t)
+	pki := newTestPKI(t)
+	addr := startTestModule(t, pki.tlsConfig(testModuleIdentity, testStoneWorkIdentity))
+
+	// plaintext
+	var noTLS *cnfTLS
+	err := callTestModule(addr, noTLS.dialOption(testModuleIdentity))
+	Expect(status.Code(err)).To(Equal(codes.Unavailable))
+
+	// TLS without client certificate
+	swTLS, err := loadTLS(pki.tlsConfig(testStoneWorkIdentity, ""))
+	Expect(err).ToNot(HaveOccurred())
+	clientConfig := swTLS.clientConfig(testModuleIdentity)
+	clientConfig.Certificates = nil
+	err = callTestModule(addr, grpc.WithTransportCredentials(credentials.NewTLS(clientConfig)))
+	Expect(err).To(HaveOccurred())
+
+	// client certificate issued for other identity than StoneWork
+	otherTLS, err := loadTLS(pki.tlsConfig("other.stonework.local", ""))
+	Expect(err).ToNot(HaveOccurred())
+	err = callTestModule(addr, otherTLS.dialOption(testModuleIdentity))
+	Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+}
+
+func TestCheckServerTLS(t *testing.T) {
+	RegisterTestingT(t)
+	tests := []struct {
+		name    string
+		config  *grpc_plugin.Config
+		wantErr bool
+	}{
+		{
+			name:    "no config",
+			wantErr: true,
+		},
+		{
+			name:    "plaintext",
+			config:  &grpc_plugin.Config{Endpoint: "0.0.0.0:9111"},
+			wantErr: true,
+		},
+		{
+			name: "without client certificates",
+			config: &grpc_plugin.Config{
+				Certfile: "/etc/stonework/tls/cert.pem",
+				Keyfile:  "/etc/stonework/tls/key.pem",
+			},
+			wantErr: true,
+		},
+		{
+			name: "mutual TLS",
+			config: &grpc_plugin.Config{
+				Certfile: "/etc/stonework/tls/cert.pem",
+				Keyfile:  "/etc/stonework/tls/key.pem",
+				CAfiles:  []string{"/etc/stonework/tls/ca.pem"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkServerTLS(test.config)
+			if test.wantErr {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
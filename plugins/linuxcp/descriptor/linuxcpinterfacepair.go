@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package descriptor
+
+import (
+	"strings"
+
+	"github.com/go-errors/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+
+	"go.pantheon.tech/stonework/plugins/linuxcp/descriptor/adapter"
+	"go.pantheon.tech/stonework/plugins/linuxcp/vppcalls"
+	linuxcp "go.pantheon.tech/stonework/proto/linuxcp"
+)
+
+const (
+	// LinuxCPInterfacePairDescriptorName is descriptor name
+	LinuxCPInterfacePairDescriptorName = "vpp-linuxcp-interface-pair"
+
+	// dependency labels
+	linuxCPInterfaceDep = "interface-exists"
+
+	// limits of the linux-cp binary API
+	maxHostIfNameLen = 15
+	maxNetnsLen      = 31
+)
+
+var (
+	// errEmptyInterface is returned when interface is empty or blank-spaced name.
+	errEmptyInterface = errors.New("Interface name must be defined")
+	// errEmptyHostIfName is returned when host interface is empty or blank-spaced name.
+	errEmptyHostIfName = errors.New("Host interface name must be defined")
+	// errHostIfNameTooLong is returned when host interface name does not fit into Linux interface name.
+	errHostIfNameTooLong = errors.Errorf("Host interface name must not be longer than %d characters", maxHostIfNameLen)
+	// errNetnsTooLong is returned when name of the network namespace is too long for linux-cp.
+	errNetnsTooLong = errors.Errorf("Network namespace name must not be longer than %d characters", maxNetnsLen)
+)
+
+// LinuxCPInterfacePairDescriptor is descriptor for LinuxCPInterfacePair
+type LinuxCPInterfacePairDescriptor struct {
+	log            logging.Logger
+	linuxCPHandler vppcalls.LinuxCPVppAPI
+}
+
+// NewLinuxCPInterfacePairDescriptor is constructor for LinuxCPInterfacePair descriptor and returns descriptor
+// suitable for registration (via adapter) with the KVScheduler.
+func NewLinuxCPInterfacePairDescriptor(linuxCPHandler vppcalls.LinuxCPVppAPI, logger logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &LinuxCPInterfacePairDescriptor{
+		log:            logger.NewLogger("linuxcp-descriptor"),
+		linuxCPHandler: linuxCPHandler,
+	}
+	typedDescr := &adapter.LinuxCPInterfacePairDescriptor{
+		Name:          LinuxCPInterfacePairDescriptorName,
+		NBKeyPrefix:   linuxcp.ModelLinuxCPInterfacePair.KeyPrefix(),
+		ValueTypeName: linuxcp.ModelLinuxCPInterfacePair.ProtoName(),
+		KeySelector:   linuxcp.ModelLinuxCPInterfacePair.IsKeyValid,
+		KeyLabel:      linuxcp.ModelLinuxCPInterfacePair.StripKeyPrefix,
+		WithMetadata:  false,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Dependencies:  ctx.Dependencies,
+		// without Update, the pair is re-created whenever it changes (linux-cp cannot modify existing pair)
+		// linux-cp dumps pairs only with a cursor-based API not supported by the channel (Retrieve is not implemented)
+	}
+	return adapter.NewLinuxCPInterfacePairDescriptor(typedDescr)
+}
+
+// Validate validates VPP linux-cp interface pair configuration.
+func (d *LinuxCPInterfacePairDescriptor) Validate(key string, pair *linuxcp.LinuxCPInterfacePair) error {
+	if strings.TrimSpace(pair.GetInterface()) == "" {
+		return kvs.NewInvalidValueError(errEmptyInterface, "interface")
+	}
+	if strings.TrimSpace(pair.GetHostIfName()) == "" {
+		return kvs.NewInvalidValueError(errEmptyHostIfName, "host_if_name")
+	}
+	if len(pair.GetHostIfName()) > maxHostIfNameLen {
+		return kvs.NewInvalidValueError(errHostIfNameTooLong, "host_if_name")
+	}
+	if len(pair.GetNetns()) > maxNetnsLen {
+		return kvs.NewInvalidValueError(errNetnsTooLong, "netns")
+	}
+	return nil
+}
+
+// Create mirrors the VPP interface into Linux using vppcalls
+func (d *LinuxCPInterfacePairDescriptor) Create(key string, pair *linuxcp.LinuxCPInterfacePair) (metadata interface{}, err error) {
+	if err := d.linuxCPHandler.AddInterfacePair(pair); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes the host interface mirroring the VPP interface using vppcalls
+func (d *LinuxCPInterfacePairDescriptor) Delete(key string, pair *linuxcp.LinuxCPInterfacePair, metadata interface{}) error {
+	if err := d.linuxCPHandler.DeleteInterfacePair(pair); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Dependencies provide list of dependencies for the linux-cp interface pair
+func (d *LinuxCPInterfacePairDescriptor) Dependencies(key string, pair *linuxcp.LinuxCPInterfacePair) (dependencies []kvs.Dependency) {
+	dependencies = append(dependencies, kvs.Dependency{
+		Label: linuxCPInterfaceDep,
+		Key:   interfaces.InterfaceKey(pair.Interface),
+	})
+	return dependencies
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:generate descriptor-adapter --descriptor-name LinuxCPInterfacePair --value-type *vpp_linuxcp.LinuxCPInterfacePair --import "go.pantheon.tech/stonework/proto/linuxcp" --output-dir "descriptor"
+
+package linuxcpplugin
+
+import (
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+
+	"go.pantheon.tech/stonework/plugins/linuxcp/descriptor"
+	"go.pantheon.tech/stonework/plugins/linuxcp/vppcalls"
+
+	_ "go.pantheon.tech/stonework/plugins/linuxcp/vppcalls/vpp2306"
+)
+
+// LinuxCPPlugin is a plugin that manages interface pairs of the VPP linux-cp plugin, i.e. VPP interfaces
+// mirrored into Linux. It is used by Punt Manager for the LINUX_CP punt type.
+type LinuxCPPlugin struct {
+	Deps
+
+	// handlers and descriptors
+	linuxCPHandler    vppcalls.LinuxCPVppAPI
+	linuxCPDescriptor *kvs.KVDescriptor
+}
+
+// Deps represents dependencies for the plugin.
+type Deps struct {
+	infra.PluginDeps
+	Scheduler   kvs.KVScheduler
+	GoVppmux    govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+// Init initializes LinuxCP plugin.
+func (p *LinuxCPPlugin) Init() error {
+	// init handler
+	p.linuxCPHandler = vppcalls.CompatibleLinuxCPVppHandler(p.GoVppmux, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.linuxCPHandler == nil {
+		// linux-cp plugin is not loaded by VPP
+		p.Log.Warn("linuxCPHandler is not available, Linux-CP interface pairs will not be configured")
+		return nil
+	}
+
+	// init & register descriptor
+	p.linuxCPDescriptor = descriptor.NewLinuxCPInterfacePairDescriptor(p.linuxCPHandler, p.Log)
+	if err := p.Deps.Scheduler.RegisterKVDescriptor(p.linuxCPDescriptor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *LinuxCPPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
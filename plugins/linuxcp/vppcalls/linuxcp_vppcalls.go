@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+
+	linuxcp "go.pantheon.tech/stonework/proto/linuxcp"
+)
+
+// LinuxCPVppAPI provides methods required to handle VPP linux-cp interface pairs
+type LinuxCPVppAPI interface {
+	// AddInterfacePair mirrors the VPP interface into Linux as the given host interface
+	AddInterfacePair(pair *linuxcp.LinuxCPInterfacePair) error
+	// DeleteInterfacePair removes the host interface mirroring the given VPP interface
+	DeleteInterfacePair(pair *linuxcp.LinuxCPInterfacePair) error
+}
+
+var handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "linuxcp",
+	HandlerAPI: (*LinuxCPVppAPI)(nil),
+})
+
+func AddLinuxCPHandlerVersion(version vpp.Version, msgs []govppapi.Message,
+	h func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) LinuxCPVppAPI,
+) {
+	handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleLinuxCPVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex,
+	log logging.Logger) LinuxCPVppAPI {
+	if v := handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(LinuxCPVppAPI)
+	}
+	return nil
+}
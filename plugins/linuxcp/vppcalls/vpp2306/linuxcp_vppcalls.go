@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	"fmt"
+
+	"github.com/go-errors/errors"
+
+	"go.pantheon.tech/stonework/plugins/binapi/vpp2306/interface_types"
+	"go.pantheon.tech/stonework/plugins/binapi/vpp2306/lcp"
+	linuxcp "go.pantheon.tech/stonework/proto/linuxcp"
+)
+
+// AddInterfacePair mirrors the VPP interface into Linux as the given host interface
+func (h *LinuxCPVppHandler) AddInterfacePair(pair *linuxcp.LinuxCPInterfacePair) error {
+	if err := h.addDelInterfacePair(true, pair); err != nil {
+		return errors.Errorf("failed to add linux-cp pair for interface %s due to: %v", pair.GetInterface(), err)
+	}
+	return nil
+}
+
+// DeleteInterfacePair removes the host interface mirroring the given VPP interface
+func (h *LinuxCPVppHandler) DeleteInterfacePair(pair *linuxcp.LinuxCPInterfacePair) error {
+	if err := h.addDelInterfacePair(false, pair); err != nil {
+		return errors.Errorf("failed to delete linux-cp pair for interface %s due to: %v", pair.GetInterface(), err)
+	}
+	return nil
+}
+
+func (h *LinuxCPVppHandler) addDelInterfacePair(isAdd bool, pair *linuxcp.LinuxCPInterfacePair) error {
+	// translate interface name to vpp interface index
+	meta, found := h.ifIndexes.LookupByName(pair.GetInterface())
+	if !found {
+		return errors.Errorf("interface %s not found", pair.GetInterface())
+	}
+
+	// construct request
+	req := &lcp.LcpItfPairAddDelV2{
+		IsAdd:      isAdd,
+		SwIfIndex:  interface_types.InterfaceIndex(meta.SwIfIndex),
+		HostIfName: pair.GetHostIfName(),
+		HostIfType: lcp.LCP_API_ITF_HOST_TAP,
+		Netns:      pair.GetNetns(),
+	}
+	if pair.GetHostIfType() == linuxcp.LinuxCPInterfacePair_TUN {
+		req.HostIfType = lcp.LCP_API_ITF_HOST_TUN
+	}
+	reply := &lcp.LcpItfPairAddDelV2Reply{}
+
+	// send, wait for and handle reply
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	if reply.Retval != 0 {
+		return fmt.Errorf("vpp call %q returned: %d", reply.GetMessageName(), reply.Retval)
+	}
+
+	return nil
+}
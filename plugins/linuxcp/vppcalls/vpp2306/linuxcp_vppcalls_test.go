@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package vpp2306_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+
+	"go.pantheon.tech/stonework/plugins/binapi/vpp2306/lcp"
+	"go.pantheon.tech/stonework/plugins/linuxcp/vppcalls"
+	"go.pantheon.tech/stonework/plugins/linuxcp/vppcalls/vpp2306"
+	linuxcp "go.pantheon.tech/stonework/proto/linuxcp"
+)
+
+func linuxCPTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.LinuxCPVppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	ifIdx := ifaceidx.NewIfaceIndex(log, "if-index")
+	linuxCPHandler := vpp2306.NewLinuxCPVppHandler(ctx.MockChannel, ifIdx, log)
+	return ctx, linuxCPHandler, ifIdx
+}
+
+func TestAddDeleteInterfacePair(t *testing.T) {
+	// Prepare different cases
+	cases := []struct {
+		Name             string
+		Add              bool
+		Pair             *linuxcp.LinuxCPInterfacePair
+		ExpectFailure    bool
+		ExpectedRequest  *lcp.LcpItfPairAddDelV2
+		MockReply        govppapi.Message
+		PrepareIfIndexes func(ifIndexes ifaceidx.IfaceMetadataIndexRW)
+	}{
+		{
+			Name: "add TAP pair",
+			Add:  true,
+			Pair: &linuxcp.LinuxCPInterfacePair{
+				Interface:  "interface1",
+				HostIfName: "host1",
+				Netns:      "ns1",
+			},
+			ExpectedRequest: &lcp.LcpItfPairAddDelV2{
+				IsAdd:      true,
+				SwIfIndex:  11,
+				HostIfName: "host1",
+				HostIfType: lcp.LCP_API_ITF_HOST_TAP,
+				Netns:      "ns1",
+			},
+			MockReply: &lcp.LcpItfPairAddDelV2Reply{},
+			PrepareIfIndexes: func(ifIndexes ifaceidx.IfaceMetadataIndexRW) {
+				ifIndexes.Put("interface1", &ifaceidx.IfaceMetadata{SwIfIndex: 11})
+			},
+		},
+		{
+			Name: "add TUN pair",
+			Add:  true,
+			Pair: &linuxcp.LinuxCPInterfacePair{
+				Interface:  "interface1",
+				HostIfName: "host1",
+				HostIfType: linuxcp.LinuxCPInterfacePair_TUN,
+			},
+			ExpectedRequest: &lcp.LcpItfPairAddDelV2{
+				IsAdd:      true,
+				SwIfIndex:  11,
+				HostIfName: "host1",
+				HostIfType: lcp.LCP_API_ITF_HOST_TUN,
+			},
+			MockReply: &lcp.LcpItfPairAddDelV2Reply{},
+			PrepareIfIndexes: func(ifIndexes ifaceidx.IfaceMetadataIndexRW) {
+				ifIndexes.Put("interface1", &ifaceidx.IfaceMetadata{SwIfIndex: 11})
+			},
+		},
+		{
+			Name: "delete pair",
+			Add:  false,
+			Pair: &linuxcp.LinuxCPInterfacePair{
+				Interface:  "interface1",
+				HostIfName: "host1",
+			},
+			ExpectedRequest: &lcp.LcpItfPairAddDelV2{
+				IsAdd:      false,
+				SwIfIndex:  11,
+				HostIfName: "host1",
+				HostIfType: lcp.LCP_API_ITF_HOST_TAP,
+			},
+			MockReply: &lcp.LcpItfPairAddDelV2Reply{},
+			PrepareIfIndexes: func(ifIndexes ifaceidx.IfaceMetadataIndexRW) {
+				ifIndexes.Put("interface1", &ifaceidx.IfaceMetadata{SwIfIndex: 11})
+			},
+		},
+		{
+			Name: "no index for interface",
+			Add:  true,
+			Pair: &linuxcp.LinuxCPInterfacePair{
+				Interface:  "interface1",
+				HostIfName: "host1",
+			},
+			ExpectFailure:    true,
+			MockReply:        &lcp.LcpItfPairAddDelV2Reply{},
+			PrepareIfIndexes: func(ifIndexes ifaceidx.IfaceMetadataIndexRW) {},
+		},
+		{
+			Name: "error from vpp",
+			Add:  true,
+			Pair: &linuxcp.LinuxCPInterfacePair{
+				Interface:  "interface1",
+				HostIfName: "host1",
+			},
+			ExpectFailure: true,
+			MockReply:     &lcp.LcpItfPairAddDelV2Reply{Retval: 1},
+			PrepareIfIndexes: func(ifIndexes ifaceidx.IfaceMetadataIndexRW) {
+				ifIndexes.Put("interface1", &ifaceidx.IfaceMetadata{SwIfIndex: 11})
+			},
+		},
+	}
+
+	// Run all cases
+	for _, td := range cases {
+		t.Run(td.Name, func(t *testing.T) {
+			ctx, linuxCPHandler, ifIndexes := linuxCPTestSetup(t)
+			defer ctx.TeardownTestCtx()
+
+			// prepare for case
+			td.PrepareIfIndexes(ifIndexes)
+			ctx.MockVpp.MockReply(td.MockReply)
+
+			// make the call and verify
+			var err error
+			if td.Add {
+				err = linuxCPHandler.AddInterfacePair(td.Pair)
+			} else {
+				err = linuxCPHandler.DeleteInterfacePair(td.Pair)
+			}
+			if td.ExpectFailure {
+				Expect(err).Should(HaveOccurred())
+				return
+			}
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ctx.MockChannel.Msg).To(Equal(td.ExpectedRequest))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package descriptor
+
+import (
+	"strings"
+
+	"github.com/go-errors/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+
+	"go.pantheon.tech/stonework/plugins/ndproxy/descriptor/adapter"
+	"go.pantheon.tech/stonework/plugins/ndproxy/vppcalls"
+	ndproxy "go.pantheon.tech/stonework/proto/ndproxy"
+)
+
+const (
+	// NDProxyInterfaceDescriptorName is descriptor name
+	NDProxyInterfaceDescriptorName = "vpp-ndproxy-interface"
+
+	// dependency labels
+	ndProxyInterfaceDep = "interface-exists"
+)
+
+var (
+	// errEmptyInterface is returned when interface is empty or blank-spaced name.
+	errEmptyInterface = errors.New("Interface name must be defined")
+)
+
+// NDProxyInterfaceDescriptor is descriptor for NDProxyInterface
+type NDProxyInterfaceDescriptor struct {
+	log            logging.Logger
+	ndProxyHandler vppcalls.NDProxyVppAPI
+}
+
+// NewNDProxyInterfaceDescriptor is constructor for NDProxyInterface descriptor and returns descriptor
+// suitable for registration (via adapter) with the KVScheduler.
+func NewNDProxyInterfaceDescriptor(ndProxyHandler vppcalls.NDProxyVppAPI, logger logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NDProxyInterfaceDescriptor{
+		log:            logger.NewLogger("ndproxy-descriptor"),
+		ndProxyHandler: ndProxyHandler,
+	}
+	typedDescr := &adapter.NDProxyInterfaceDescriptor{
+		Name:          NDProxyInterfaceDescriptorName,
+		NBKeyPrefix:   ndproxy.ModelNDProxyInterface.KeyPrefix(),
+		ValueTypeName: ndproxy.ModelNDProxyInterface.ProtoName(),
+		KeySelector:   ndproxy.ModelNDProxyInterface.IsKeyValid,
+		KeyLabel:      ndproxy.ModelNDProxyInterface.StripKeyPrefix,
+		WithMetadata:  false,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Dependencies:  ctx.Dependencies,
+		// VPP does not provide a dump of interfaces with enabled ND proxy (Retrieve is not implemented)
+	}
+	return adapter.NewNDProxyInterfaceDescriptor(typedDescr)
+}
+
+// Validate validates VPP ND proxy configuration.
+func (d *NDProxyInterfaceDescriptor) Validate(key string, ndProxy *ndproxy.NDProxyInterface) error {
+	if strings.TrimSpace(ndProxy.GetInterface()) == "" {
+		return kvs.NewInvalidValueError(errEmptyInterface, "interface")
+	}
+	return nil
+}
+
+// Create enables ND proxy on the interface using vppcalls
+func (d *NDProxyInterfaceDescriptor) Create(key string, ndProxy *ndproxy.NDProxyInterface) (metadata interface{}, err error) {
+	if err := d.ndProxyHandler.EnableNDProxy(ndProxy.GetInterface()); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete disables ND proxy on the interface using vppcalls
+func (d *NDProxyInterfaceDescriptor) Delete(key string, ndProxy *ndproxy.NDProxyInterface, metadata interface{}) error {
+	if err := d.ndProxyHandler.DisableNDProxy(ndProxy.GetInterface()); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Dependencies provide list of dependencies for enabling ND proxy
+func (d *NDProxyInterfaceDescriptor) Dependencies(key string, ndProxy *ndproxy.NDProxyInterface) (dependencies []kvs.Dependency) {
+	dependencies = append(dependencies, kvs.Dependency{
+		Label: ndProxyInterfaceDep,
+		Key:   interfaces.InterfaceKey(ndProxy.Interface),
+	})
+	return dependencies
+}
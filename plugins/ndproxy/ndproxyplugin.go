@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:generate descriptor-adapter --descriptor-name NDProxyInterface --value-type *vpp_ndproxy.NDProxyInterface --import "go.pantheon.tech/stonework/proto/ndproxy" --output-dir "descriptor"
+
+package ndproxyplugin
+
+import (
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+
+	"go.pantheon.tech/stonework/plugins/ndproxy/descriptor"
+	"go.pantheon.tech/stonework/plugins/ndproxy/vppcalls"
+
+	_ "go.pantheon.tech/stonework/plugins/ndproxy/vppcalls/vpp2306"
+)
+
+// API of the NDProxy plugin.
+type API interface {
+	// IsNDProxySupported returns true if IPv6 ND proxy can be configured with the connected VPP
+	// (i.e. NDProxyInterface values are handled). The result is known only after the plugin is initialized.
+	IsNDProxySupported() bool
+}
+
+// NDProxyPlugin is a plugin that manages IPv6 ND proxy enabled on VPP interfaces.
+// It is the IPv6 counterpart of the proxy ARP, used by Punt Manager for interconnects
+// that are unnumbered to proxied L3 VPP interfaces.
+type NDProxyPlugin struct {
+	Deps
+
+	// handlers and descriptors
+	ndProxyHandler    vppcalls.NDProxyVppAPI
+	ndProxyDescriptor *kvs.KVDescriptor
+}
+
+// Deps represents dependencies for the plugin.
+type Deps struct {
+	infra.PluginDeps
+	Scheduler   kvs.KVScheduler
+	GoVppmux    govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+// Init initializes NDProxy plugin.
+func (p *NDProxyPlugin) Init() error {
+	// init handler
+	p.ndProxyHandler = vppcalls.CompatibleNDProxyVppHandler(p.GoVppmux, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.ndProxyHandler == nil {
+		// IPv6 ND proxy (enabled per interface) is not supported by older VPP versions
+		p.Log.Warn("ndProxyHandler is not available, IPv6 ND proxy will not be configured")
+		return nil
+	}
+
+	// init & register descriptor
+	p.ndProxyDescriptor = descriptor.NewNDProxyInterfaceDescriptor(p.ndProxyHandler, p.Log)
+	if err := p.Deps.Scheduler.RegisterKVDescriptor(p.ndProxyDescriptor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsNDProxySupported returns true if IPv6 ND proxy can be configured with the connected VPP.
+func (p *NDProxyPlugin) IsNDProxySupported() bool {
+	return p.ndProxyDescriptor != nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *NDProxyPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+)
+
+// NDProxyVppAPI provides methods required to handle VPP IPv6 ND proxy
+type NDProxyVppAPI interface {
+	// EnableNDProxy enables IPv6 ND proxy on the given interface
+	EnableNDProxy(ifName string) error
+	// DisableNDProxy disables IPv6 ND proxy on the given interface
+	DisableNDProxy(ifName string) error
+}
+
+var handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "ndproxy",
+	HandlerAPI: (*NDProxyVppAPI)(nil),
+})
+
+func AddNDProxyHandlerVersion(version vpp.Version, msgs []govppapi.Message,
+	h func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) NDProxyVppAPI,
+) {
+	handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleNDProxyVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex,
+	log logging.Logger) NDProxyVppAPI {
+	if v := handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(NDProxyVppAPI)
+	}
+	return nil
+}
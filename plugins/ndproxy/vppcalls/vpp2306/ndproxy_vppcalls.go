@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	"fmt"
+
+	"github.com/go-errors/errors"
+
+	"go.pantheon.tech/stonework/plugins/binapi/vpp2306/interface_types"
+	"go.pantheon.tech/stonework/plugins/binapi/vpp2306/ip6_nd"
+)
+
+// EnableNDProxy enables IPv6 ND proxy on the given interface
+func (h *NDProxyVppHandler) EnableNDProxy(ifName string) error {
+	if err := h.enableDisableNDProxy(true, ifName); err != nil {
+		return errors.Errorf("failed to enable IPv6 ND proxy on interface %s due to: %v", ifName, err)
+	}
+	return nil
+}
+
+// DisableNDProxy disables IPv6 ND proxy on the given interface
+func (h *NDProxyVppHandler) DisableNDProxy(ifName string) error {
+	if err := h.enableDisableNDProxy(false, ifName); err != nil {
+		return errors.Errorf("failed to disable IPv6 ND proxy on interface %s due to: %v", ifName, err)
+	}
+	return nil
+}
+
+func (h *NDProxyVppHandler) enableDisableNDProxy(enable bool, ifName string) error {
+	// translate interface name to vpp interface index
+	meta, found := h.ifIndexes.LookupByName(ifName)
+	if !found {
+		return errors.Errorf("interface %s not found", ifName)
+	}
+
+	// construct request
+	req := &ip6_nd.IP6ndProxyEnableDisable{
+		SwIfIndex: interface_types.InterfaceIndex(meta.SwIfIndex),
+		IsEnable:  enable,
+	}
+	reply := &ip6_nd.IP6ndProxyEnableDisableReply{}
+
+	// send, wait for and handle reply
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	if reply.Retval != 0 {
+		return fmt.Errorf("vpp call %q returned: %d", reply.GetMessageName(), reply.Retval)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package vpp2306_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+
+	"go.pantheon.tech/stonework/plugins/binapi/vpp2306/ip6_nd"
+	"go.pantheon.tech/stonework/plugins/ndproxy/vppcalls"
+	"go.pantheon.tech/stonework/plugins/ndproxy/vppcalls/vpp2306"
+)
+
+func ndProxyTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.NDProxyVppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	ifIdx := ifaceidx.NewIfaceIndex(log, "if-index")
+	ndProxyHandler := vpp2306.NewNDProxyVppHandler(ctx.MockChannel, ifIdx, log)
+	return ctx, ndProxyHandler, ifIdx
+}
+
+func TestEnableDisableNDProxy(t *testing.T) {
+	// Prepare different cases
+	cases := []struct {
+		Name             string
+		Enable           bool
+		ExpectFailure    bool
+		MockReply        govppapi.Message
+		PrepareIfIndexes func(ifIndexes ifaceidx.IfaceMetadataIndexRW)
+	}{
+		{
+			Name:          "enable",
+			Enable:        true,
+			ExpectFailure: false,
+			MockReply:     &ip6_nd.IP6ndProxyEnableDisableReply{},
+			PrepareIfIndexes: func(ifIndexes ifaceidx.IfaceMetadataIndexRW) {
+				ifIndexes.Put("interface1", &ifaceidx.IfaceMetadata{SwIfIndex: 11})
+			},
+		},
+		{
+			Name:          "disable",
+			Enable:        false,
+			ExpectFailure: false,
+			MockReply:     &ip6_nd.IP6ndProxyEnableDisableReply{},
+			PrepareIfIndexes: func(ifIndexes ifaceidx.IfaceMetadataIndexRW) {
+				ifIndexes.Put("interface1", &ifaceidx.IfaceMetadata{SwIfIndex: 11})
+			},
+		},
+		{
+			Name:             "no index for interface",
+			Enable:           true,
+			ExpectFailure:    true,
+			MockReply:        &ip6_nd.IP6ndProxyEnableDisableReply{},
+			PrepareIfIndexes: func(ifIndexes ifaceidx.IfaceMetadataIndexRW) {},
+		},
+		{
+			Name:          "error from vpp",
+			Enable:        true,
+			ExpectFailure: true,
+			MockReply:     &ip6_nd.IP6ndProxyEnableDisableReply{Retval: 1},
+			PrepareIfIndexes: func(ifIndexes ifaceidx.IfaceMetadataIndexRW) {
+				ifIndexes.Put("interface1", &ifaceidx.IfaceMetadata{SwIfIndex: 11})
+			},
+		},
+	}
+
+	// Run all cases
+	for _, td := range cases {
+		t.Run(td.Name, func(t *testing.T) {
+			ctx, ndProxyHandler, ifIndexes := ndProxyTestSetup(t)
+			defer ctx.TeardownTestCtx()
+
+			// prepare for case
+			td.PrepareIfIndexes(ifIndexes)
+			ctx.MockVpp.MockReply(td.MockReply)
+
+			// make the call and verify
+			var err error
+			if td.Enable {
+				err = ndProxyHandler.EnableNDProxy("interface1")
+			} else {
+				err = ndProxyHandler.DisableNDProxy("interface1")
+			}
+			if td.ExpectFailure {
+				Expect(err).Should(HaveOccurred())
+			} else {
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+		})
+	}
+}
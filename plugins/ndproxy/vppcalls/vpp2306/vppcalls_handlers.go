@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+
+	binapi "go.pantheon.tech/stonework/plugins/binapi/vpp2306"
+	"go.pantheon.tech/stonework/plugins/binapi/vpp2306/ip6_nd"
+	"go.pantheon.tech/stonework/plugins/ndproxy/vppcalls"
+)
+
+func init() {
+	msgs := []govppapi.Message{
+		&ip6_nd.IP6ndProxyEnableDisable{},
+		&ip6_nd.IP6ndProxyEnableDisableReply{},
+	}
+
+	vppcalls.AddNDProxyHandlerVersion(binapi.Version, msgs, NewNDProxyVppHandler)
+}
+
+// NDProxyVppHandler is accessor for ND-proxy-related vppcalls methods
+type NDProxyVppHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+// NewNDProxyVppHandler returns new NDProxyVppHandler.
+func NewNDProxyVppHandler(calls govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex,
+	log logging.Logger) vppcalls.NDProxyVppAPI {
+	return &NDProxyVppHandler{
+		callsChannel: calls,
+		ifIndexes:    ifIdx,
+		log:          log,
+	}
+}
@@ -17,6 +17,7 @@
 package puntmgr
 
 import (
+	"encoding/json"
 	"fmt"
 	"hash/fnv"
 	"net"
@@ -47,6 +48,9 @@ type abxPunt struct {
 	ifPlugin ifplugin.API
 	// ABX Punt handler needs in-memory cache for the sake of multiplexing
 	abxPunts map[string][]abxPuntMeta
+	// ABX priorities restored from the checkpoint and not yet re-claimed,
+	// key = VPP interface, then interconnect interface
+	restoredPrio map[string]map[string]uint32
 }
 
 type abxPuntMeta struct {
@@ -56,10 +60,18 @@ type abxPuntMeta struct {
 	priority uint32
 }
 
+// abxCheckpoint is used to persist ABX priorities.
+type abxCheckpoint struct {
+	VppInterface string `json:"vpp-interface"`
+	IcInterface  string `json:"ic-interface"`
+	Priority     uint32 `json:"priority"`
+}
+
 func NewAbxPuntHandler(ifPlugin ifplugin.API) PuntHandler {
 	return &abxPunt{
-		ifPlugin: ifPlugin,
-		abxPunts: make(map[string][]abxPuntMeta),
+		ifPlugin:     ifPlugin,
+		abxPunts:     make(map[string][]abxPuntMeta),
+		restoredPrio: make(map[string]map[string]uint32),
 	}
 }
 
@@ -119,9 +131,9 @@ func (p *abxPunt) GetPuntDependencies(punt *pb.PuntRequest) (deps []kvs.Dependen
 
 // CanMultiplex enables interconnection multiplexing for this punting. It could be enabled in certain cases:
 // 1. two or more punts of this type can coexist even if they have the same vpp selector
-// 2. one or more punts of this type can coexist with other type of punts on the same (TAP-only)
+// 2. one or more punts of this type can coexist with other type of punts on the same (TAP, AF_PACKET or AF_XDP)
 // interconnection if they all have the same vpp selector and cnf selector.
-// The TAP-backed interconnection is shared for multiple multiplexing punts with the same cnf selector
+// The TAP/veth-backed interconnection is shared for multiple multiplexing punts with the same cnf selector
 // (same network namespace) and vpp selector.
 func (p *abxPunt) CanMultiplex() bool {
 	return true
@@ -144,6 +156,22 @@ func (p *abxPunt) ConfigurePunt(txn client.ChangeRequest, puntId puntID, puntReq
 			abxPrio = abx.priority
 		}
 	}
+	// do not collide with priorities used before restart
+	for _, prio := range p.restoredPrio[vppInterface] {
+		if prio > maxPrio {
+			maxPrio = prio
+		}
+	}
+	if prio, isRestored := p.restoredPrio[vppInterface][interconnect.VppInterface.Name]; isRestored && !remove {
+		if abxPrio == 0 {
+			// keep the priority used before restart
+			abxPrio = prio
+		}
+		delete(p.restoredPrio[vppInterface], interconnect.VppInterface.Name)
+		if len(p.restoredPrio[vppInterface]) == 0 {
+			delete(p.restoredPrio, vppInterface)
+		}
+	}
 	if abxPrio == 0 {
 		abxPrio = maxPrio + 1
 	}
@@ -253,6 +281,50 @@ func (p *abxPunt) ConfigurePunt(txn client.ChangeRequest, puntId puntID, puntReq
 	return nil
 }
 
+// checkpoint returns ABX priorities to persist.
+func (p *abxPunt) checkpoint() (json.RawMessage, error) {
+	var state []abxCheckpoint
+	for vppInterface, abxs := range p.abxPunts {
+		for _, abx := range abxs {
+			state = append(state, abxCheckpoint{
+				VppInterface: vppInterface,
+				IcInterface:  abx.icIface,
+				Priority:     abx.priority,
+			})
+		}
+	}
+	// keep restored priorities that were not yet re-claimed
+	for vppInterface, restored := range p.restoredPrio {
+		for icIface, prio := range restored {
+			state = append(state, abxCheckpoint{
+				VppInterface: vppInterface,
+				IcInterface:  icIface,
+				Priority:     prio,
+			})
+		}
+	}
+	return json.Marshal(state)
+}
+
+// restore preloads ABX priorities used before restart.
+func (p *abxPunt) restore(state json.RawMessage) error {
+	p.restoredPrio = make(map[string]map[string]uint32)
+	if state == nil {
+		return nil
+	}
+	var restored []abxCheckpoint
+	if err := json.Unmarshal(state, &restored); err != nil {
+		return err
+	}
+	for _, abx := range restored {
+		if p.restoredPrio[abx.VppInterface] == nil {
+			p.restoredPrio[abx.VppInterface] = make(map[string]uint32)
+		}
+		p.restoredPrio[abx.VppInterface][abx.IcInterface] = abx.Priority
+	}
+	return nil
+}
+
 // processAclRules translates special address constants like "local" and "any" to actual IP addresses.
 func (p *abxPunt) processAclRules(vppInterface string, in []*vppacl.ACL_Rule_IpRule) (out []*vppacl.ACL_Rule_IpRule, err error) {
 	// obtain the list of IP addresses assigned to the interface
@@ -0,0 +1,321 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puntmgr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+
+	pb "go.pantheon.tech/stonework/proto/puntmgr"
+)
+
+// CheckpointDescriptorName is the name of the descriptor reconciling the checkpoint with VPP.
+const CheckpointDescriptorName = "punt-checkpoint"
+
+// checkpoint is the persisted state of the Punt Manager.
+// Only the part of the state that cannot be deterministically rebuilt from punt requests is stored.
+// Interconnects, VRF reference counts and proxied interfaces are re-created as the punts are re-added
+// after restart (by the same punt requests), using the checkpointed allocations.
+type checkpoint struct {
+	Punts         []*checkpointedPunt        `json:"punts"`
+	Interconnects *InterconnectCheckpoint    `json:"interconnects"`
+	Handlers      map[string]json.RawMessage `json:"handlers,omitempty"` // key = punt type
+}
+
+// checkpointedPunt is a punt stored in the checkpoint.
+type checkpointedPunt struct {
+	State    string          `json:"state"`
+	Request  json.RawMessage `json:"request"`
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+// checkpointingPuntHandler is implemented by punt handlers with an internal state that should
+// survive restart.
+type checkpointingPuntHandler interface {
+	// checkpoint returns the handler state to persist.
+	checkpoint() (json.RawMessage, error)
+	// restore preloads the handler state from the checkpoint.
+	// With nil state the handler should drop any restored state that has not been re-claimed yet.
+	restore(state json.RawMessage) error
+}
+
+// loadCheckpoint loads the state of punts and interconnects checkpointed before restart.
+// Restored punts are not configured, instead their allocations are reserved until they are re-added.
+func (p *Plugin) loadCheckpoint() error {
+	content, err := os.ReadFile(p.config.StateFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			p.Log.Debugf("PuntMgr checkpoint %s does not exist", p.config.StateFile)
+			return nil
+		}
+		return fmt.Errorf("failed to read PuntMgr checkpoint %s: %w", p.config.StateFile, err)
+	}
+	var cp checkpoint
+	if err = json.Unmarshal(content, &cp); err != nil {
+		return fmt.Errorf("failed to parse PuntMgr checkpoint %s: %w", p.config.StateFile, err)
+	}
+	for _, cpPunt := range cp.Punts {
+		restored := &punt{
+			state:    pb.PuntState(pb.PuntState_value[cpPunt.State]),
+			request:  &pb.PuntRequest{},
+			metadata: &pb.PuntMetadata{},
+		}
+		if err = protojson.Unmarshal(cpPunt.Request, restored.request); err != nil {
+			return fmt.Errorf("failed to parse checkpointed punt request: %w", err)
+		}
+		if err = protojson.Unmarshal(cpPunt.Metadata, restored.metadata); err != nil {
+			return fmt.Errorf("failed to parse checkpointed punt metadata: %w", err)
+		}
+		p.restored[puntIdFromProto(restored.metadata)] = restored
+	}
+	if cp.Interconnects != nil {
+		p.icManager.Restore(cp.Interconnects)
+	}
+	for puntType, puntHandler := range p.puntHandlers {
+		cpHandler, withCheckpoint := puntHandler.(checkpointingPuntHandler)
+		state, hasState := cp.Handlers[puntType.String()]
+		if !withCheckpoint || !hasState {
+			continue
+		}
+		if err = cpHandler.restore(state); err != nil {
+			return fmt.Errorf("failed to restore state of the %v punt handler: %w", puntType, err)
+		}
+	}
+	p.Log.Infof("Restored %d punts from the checkpoint %s", len(p.restored), p.config.StateFile)
+	return nil
+}
+
+// How long the checkpoint writer waits for further changes of the state before it writes the checkpoint.
+const checkpointWriteDelay = 200 * time.Millisecond
+
+// scheduleCheckpoint marks the state as changed, the checkpoint is written asynchronously by checkpointWriter.
+// The method should be called with the plugin locked.
+func (p *Plugin) scheduleCheckpoint() {
+	if p.cpDirty == nil {
+		// checkpointing is disabled
+		return
+	}
+	select {
+	case p.cpDirty <- struct{}{}:
+	default:
+		// already scheduled
+	}
+}
+
+// startCheckpointWriter starts checkpointWriter in the background.
+func (p *Plugin) startCheckpointWriter() {
+	p.cpDirty = make(chan struct{}, 1)
+	p.cpStop = make(chan struct{})
+	p.cpWriterDone = make(chan struct{})
+	go p.checkpointWriter()
+}
+
+// stopCheckpointWriter stops checkpointWriter, pending changes of the state are written before it returns.
+func (p *Plugin) stopCheckpointWriter() {
+	if p.cpStop == nil {
+		return
+	}
+	close(p.cpStop)
+	<-p.cpWriterDone
+}
+
+// checkpointWriter writes the checkpoint whenever the state changes. Changes made within checkpointWriteDelay
+// are written together, the plugin is locked only for as long as it takes to snapshot the state.
+func (p *Plugin) checkpointWriter() {
+	defer close(p.cpWriterDone)
+	for {
+		select {
+		case <-p.cpDirty:
+			select {
+			case <-time.After(checkpointWriteDelay):
+			case <-p.cpStop:
+			}
+			p.writeCheckpoint()
+		case <-p.cpStop:
+			select {
+			case <-p.cpDirty:
+				p.writeCheckpoint()
+			default:
+			}
+			return
+		}
+	}
+}
+
+// writeCheckpoint writes the current state of punts and interconnects into the state file.
+func (p *Plugin) writeCheckpoint() {
+	p.Lock()
+	cp, err := p.buildCheckpoint()
+	p.Unlock()
+	if err != nil {
+		p.Log.Errorf("failed to checkpoint PuntMgr state: %v", err)
+		return
+	}
+	content, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		p.Log.Errorf("failed to marshal PuntMgr checkpoint: %v", err)
+		return
+	}
+	// write atomically to never leave a partially written checkpoint behind
+	_ = os.MkdirAll(filepath.Dir(p.config.StateFile), 0755)
+	tmpFile := p.config.StateFile + ".tmp"
+	if err = os.WriteFile(tmpFile, content, 0644); err != nil {
+		p.Log.Errorf("failed to write PuntMgr checkpoint: %v", err)
+		return
+	}
+	if err = os.Rename(tmpFile, p.config.StateFile); err != nil {
+		p.Log.Errorf("failed to write PuntMgr checkpoint: %v", err)
+	}
+}
+
+// buildCheckpoint returns snapshot of the current state of punts and interconnects to persist.
+// The method should be called with the plugin locked.
+func (p *Plugin) buildCheckpoint() (*checkpoint, error) {
+	cp := &checkpoint{
+		Interconnects: p.icManager.Checkpoint(),
+		Handlers:      make(map[string]json.RawMessage),
+	}
+	addPunt := func(punt *punt) error {
+		if punt.request == nil {
+			// learned from StoneWork, nothing to persist
+			return nil
+		}
+		request, err := protojson.Marshal(punt.request)
+		if err != nil {
+			return err
+		}
+		metadata, err := protojson.Marshal(punt.metadata)
+		if err != nil {
+			return err
+		}
+		cp.Punts = append(cp.Punts, &checkpointedPunt{
+			State:    punt.state.String(),
+			Request:  request,
+			Metadata: metadata,
+		})
+		return nil
+	}
+	for _, punt := range p.punts {
+		if err := addPunt(punt); err != nil {
+			return nil, fmt.Errorf("failed to checkpoint punt: %w", err)
+		}
+	}
+	// keep restored punts that were not yet re-added
+	for _, punt := range p.restored {
+		if err := addPunt(punt); err != nil {
+			return nil, fmt.Errorf("failed to checkpoint restored punt: %w", err)
+		}
+	}
+	for puntType, puntHandler := range p.puntHandlers {
+		if cpHandler, withCheckpoint := puntHandler.(checkpointingPuntHandler); withCheckpoint {
+			state, err := cpHandler.checkpoint()
+			if err != nil {
+				return nil, fmt.Errorf("failed to checkpoint state of the %v punt handler: %w", puntType, err)
+			}
+			cp.Handlers[puntType.String()] = state
+		}
+	}
+	return cp, nil
+}
+
+// newCheckpointDescriptor returns descriptor which reconciles the state restored from the checkpoint
+// with the interfaces retrieved from VPP. The descriptor describes no values, only its Retrieve is used,
+// which is called by KVScheduler during the startup resync after VPP interfaces are retrieved.
+func (p *Plugin) newCheckpointDescriptor() *kvs.KVDescriptor {
+	return &kvs.KVDescriptor{
+		Name: CheckpointDescriptorName,
+		KeySelector: func(key string) bool {
+			return false
+		},
+		Retrieve: func(correlate []kvs.KVWithMetadata) ([]kvs.KVWithMetadata, error) {
+			p.reconcileCheckpoint()
+			return nil, nil
+		},
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+	}
+}
+
+// reconcileCheckpoint verifies subnets reserved for restored punts against the addresses of interconnect
+// interfaces retrieved from VPP. Interfaces present in VPP take precedence over the checkpoint - checkpointed
+// state of punts with interconnects which do not match is dropped (allocations follow the VPP state).
+// Reconciliation is done only once, for the state loaded on startup.
+func (p *Plugin) reconcileCheckpoint() {
+	p.Lock()
+	defer p.Unlock()
+	if p.reconciled {
+		return
+	}
+	p.reconciled = true
+	var restored []*pb.PuntMetadata
+	for _, punt := range p.restored {
+		restored = append(restored, punt.metadata)
+	}
+	mismatched := p.icManager.ReconcileRestored(restored)
+	for _, id := range mismatched {
+		p.Log.Warnf("Interconnects of the restored punt %v do not match VPP, dropping its checkpointed state", id)
+		delete(p.restored, id)
+	}
+	p.Log.Infof("Reconciled %d restored punts with VPP (%d mismatched)", len(restored), len(mismatched))
+	if len(mismatched) > 0 {
+		p.scheduleCheckpoint()
+	}
+}
+
+// claimRestoredPunt is called when a punt is re-added after restart.
+// The method should be called with the plugin locked.
+func (p *Plugin) claimRestoredPunt(id puntID, puntMeta *pb.PuntMetadata) {
+	restored, isRestored := p.restored[id]
+	if !isRestored {
+		return
+	}
+	delete(p.restored, id)
+	if !proto.Equal(restored.metadata, puntMeta) {
+		p.Log.Warnf("Metadata of the punt %v changed after restart (%+v vs. %+v)",
+			id, restored.metadata, puntMeta)
+	}
+}
+
+// dropRestoredPunts releases allocations reserved for restored punts which were not re-added
+// within the restore timeout.
+func (p *Plugin) dropRestoredPunts() {
+	p.Lock()
+	defer p.Unlock()
+
+	for id := range p.restored {
+		p.Log.Infof("Dropping checkpointed state of the punt %v which was not re-added after restart", id)
+	}
+	p.restored = make(map[puntID]*punt)
+	p.icManager.Restore(nil)
+	for puntType, puntHandler := range p.puntHandlers {
+		if cpHandler, withCheckpoint := puntHandler.(checkpointingPuntHandler); withCheckpoint {
+			if err := cpHandler.restore(nil); err != nil {
+				p.Log.Warnf("failed to drop restored state of the %v punt handler: %v", puntType, err)
+			}
+		}
+	}
+	p.scheduleCheckpoint()
+}
@@ -16,21 +16,70 @@
 
 package puntmgr
 
+import "time"
+
 const (
 	// CIDR used by default for allocations of /30 subnets for interconnects.
 	defaultInterconnectAllocCIDR = "192.168.111.0/24"
+	// Prefix length used by default for IPv6 subnets allocated for interconnects.
+	defaultInterconnectAllocPrefixLenV6 = 126
+	// File used by default to checkpoint the state of the Punt Manager.
+	defaultStateFile = "/run/stonework/puntmgr/state.json"
+	// How long to wait by default for punts to be re-added after restart before their state is dropped.
+	defaultRestoreTimeout = 5 * time.Minute
+	// Deadline used by default for RPCs and remote transactions sent to SW-Modules.
+	defaultRPCTimeout = 30 * time.Second
+	// Deadline used by default for local transactions configuring the VPP side of punts.
+	defaultLocalTxnTimeout = 2 * time.Minute
 )
 
 // Config file for PuntMgr plugin.
 type Config struct {
 	// InterconnectAllocCIDR defines network from which /30 subnets are allocated for use by VPP<->CNF interconnects.
 	InterconnectAllocCIDR string `json:"interconnect-alloc-cidr"`
+	// InterconnectAllocCIDRs defines additional networks from which /30 subnets are allocated for interconnects
+	// once InterconnectAllocCIDR is exhausted. Set InterconnectAllocCIDR to empty string to only use these networks.
+	InterconnectAllocCIDRs []string `json:"interconnect-alloc-cidrs"`
+	// InterconnectAllocCIDRv6 defines network from which IPv6 subnets are allocated for interconnects
+	// (in addition to IPv4 subnets, or instead of them if no IPv4 CIDR is configured).
+	// Empty string (default) disables allocation of IPv6 subnets.
+	InterconnectAllocCIDRv6 string `json:"interconnect-alloc-cidr-v6"`
+	// InterconnectAllocPrefixLenV6 is the prefix length of allocated IPv6 subnets, either 126 or 127.
+	InterconnectAllocPrefixLenV6 int `json:"interconnect-alloc-prefix-len-v6"`
+	// StateFile is a path to the file where the state of punts and interconnects is checkpointed,
+	// so that allocated subnets, interface names and ABX priorities are preserved across restarts.
+	// Set to empty string to disable checkpointing.
+	StateFile string `json:"state-file"`
+	// RestoreTimeout is how long the state restored from the checkpoint is kept reserved for punts
+	// that were not yet re-added after restart. Unclaimed state is dropped afterwards.
+	RestoreTimeout time.Duration `json:"restore-timeout"`
+	// SyncConfig makes AddPunt report the configuration state of the punt back to the caller: ErrPuntPending
+	// is returned until the punt is configured and configuration error is returned if the punt fails.
+	// This is intended for proxy descriptors of StoneWork, which run inside KVScheduler transaction and therefore
+	// cannot wait for the punt (its VPP side is committed only after their transaction is finalized).
+	// The proxied items complete only through the KVScheduler retry, therefore the orchestrator has to commit
+	// NB transactions through KVScheduler returned by cnfreg NBTxnScheduler (enforcing the retry), otherwise
+	// the plugin fails to initialize. ErrPuntPending wraps the failure of the previous attempt, if any.
+	// Supported only in the StoneWork mode.
+	SyncConfig bool `json:"sync-config"`
+	// RPCTimeout is a deadline for every RPC (incl. remote configuration transaction) sent to a SW-Module.
+	// Unresponsive SW-Module therefore delays punt processing only for itself and at most by this duration.
+	RPCTimeout time.Duration `json:"rpc-timeout"`
+	// LocalTxnTimeout is a deadline for local transactions configuring the VPP side of punts.
+	// The transactions are queued behind the transaction of the caller (and other transactions of KVScheduler),
+	// therefore the deadline should be generous.
+	LocalTxnTimeout time.Duration `json:"local-txn-timeout"`
 }
 
 // loadConfig returns PuntMgr plugin file configuration if exists.
 func (p *Plugin) loadConfig() (*Config, error) {
 	cfg := &Config{
-		InterconnectAllocCIDR: defaultInterconnectAllocCIDR,
+		InterconnectAllocCIDR:        defaultInterconnectAllocCIDR,
+		InterconnectAllocPrefixLenV6: defaultInterconnectAllocPrefixLenV6,
+		StateFile:                    defaultStateFile,
+		RestoreTimeout:               defaultRestoreTimeout,
+		RPCTimeout:                   defaultRPCTimeout,
+		LocalTxnTimeout:              defaultLocalTxnTimeout,
 	}
 	found, err := p.Cfg.LoadValue(cfg)
 	if err != nil {
@@ -41,6 +90,12 @@ func (p *Plugin) loadConfig() (*Config, error) {
 		return cfg, nil
 	}
 
+	if cfg.RPCTimeout <= 0 {
+		cfg.RPCTimeout = defaultRPCTimeout
+	}
+	if cfg.LocalTxnTimeout <= 0 {
+		cfg.LocalTxnTimeout = defaultLocalTxnTimeout
+	}
 	p.Log.Debugf("PuntMgr config found: %+v", cfg)
 	return cfg, nil
 }
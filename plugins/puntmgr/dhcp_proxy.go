@@ -18,6 +18,7 @@ package puntmgr
 
 import (
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 
@@ -73,9 +74,9 @@ func (p *dhcpProxyPunt) GetPuntDependencies(punt *pb.PuntRequest) (deps []kvs.De
 
 // CanMultiplex enables interconnection multiplexing for this punting. It could be enabled in certain cases:
 // 1. two or more punts of this type can coexist even if they have the same vpp selector
-// 2. one or more punts of this type can coexist with other type of punts on the same (TAP-only)
+// 2. one or more punts of this type can coexist with other type of punts on the same (TAP, AF_PACKET or AF_XDP)
 // interconnection if they all have the same vpp selector and cnf selector.
-// The TAP-backed interconnection is shared for multiple multiplexing punts with the same cnf selector
+// The TAP/veth-backed interconnection is shared for multiple multiplexing punts with the same cnf selector
 // (same network namespace) and vpp selector.
 func (p *dhcpProxyPunt) CanMultiplex() bool {
 	return false
@@ -85,22 +86,45 @@ func (p *dhcpProxyPunt) CanMultiplex() bool {
 func (p *dhcpProxyPunt) ConfigurePunt(txn client.ChangeRequest, puntId puntID, puntReq *pb.PuntRequest,
 	interconnects []*pb.PuntMetadata_Interconnect, remove bool) error {
 
-	vppIP := strings.SplitN(interconnects[0].VppInterface.IpAddresses[0], "/", 2)[0]
-	cnfIP := strings.SplitN(interconnects[0].CnfInterface.IpAddresses[0], "/", 2)[0]
-	dhcpProxy := &vpp_l3.DHCPProxy{
-		SourceIpAddress: vppIP,
-		RxVrfId:         puntReq.GetDhcpProxy().GetVrf(),
-		Servers: []*vpp_l3.DHCPProxy_DHCPServer{
-			{
-				VrfId:     puntReq.GetDhcpProxy().GetVrf(),
-				IpAddress: cnfIP,
+	// configure DHCP proxy for every IP version with addresses allocated on both sides of the interconnect
+	vppIPs := ipAddrsByVersion(interconnects[0].VppInterface.IpAddresses)
+	cnfIPs := ipAddrsByVersion(interconnects[0].CnfInterface.IpAddresses)
+	for _, ipv6 := range []bool{false, true} {
+		vppIP, cnfIP := vppIPs[ipv6], cnfIPs[ipv6]
+		if vppIP == "" || cnfIP == "" {
+			continue
+		}
+		dhcpProxy := &vpp_l3.DHCPProxy{
+			SourceIpAddress: vppIP,
+			RxVrfId:         puntReq.GetDhcpProxy().GetVrf(),
+			Servers: []*vpp_l3.DHCPProxy_DHCPServer{
+				{
+					VrfId:     puntReq.GetDhcpProxy().GetVrf(),
+					IpAddress: cnfIP,
+				},
 			},
-		},
-	}
-	if remove {
-		txn.Delete(dhcpProxy)
-	} else {
-		txn.Update(dhcpProxy)
+		}
+		if remove {
+			txn.Delete(dhcpProxy)
+		} else {
+			txn.Update(dhcpProxy)
+		}
 	}
 	return nil
 }
+
+// ipAddrsByVersion returns the first IP address (without mask) of each IP version, key = isIPv6.
+func ipAddrsByVersion(ipAddresses []string) map[bool]string {
+	ips := make(map[bool]string)
+	for _, ipAddr := range ipAddresses {
+		ip := net.ParseIP(strings.SplitN(ipAddr, "/", 2)[0])
+		if ip == nil {
+			continue
+		}
+		ipv6 := ip.To4() == nil
+		if _, has := ips[ipv6]; !has {
+			ips[ipv6] = ip.String()
+		}
+	}
+	return ips
+}
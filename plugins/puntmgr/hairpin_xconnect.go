@@ -74,9 +74,9 @@ func (p *hairpinXConnPunt) GetPuntDependencies(punt *pb.PuntRequest) (deps []kvs
 
 // CanMultiplex enables interconnection multiplexing for this punting. It could be enabled in certain cases:
 // 1. two or more punts of this type can coexist even if they have the same vpp selector
-// 2. one or more punts of this type can coexist with other type of punts on the same (TAP-only)
+// 2. one or more punts of this type can coexist with other type of punts on the same (TAP, AF_PACKET or AF_XDP)
 // interconnection if they all have the same vpp selector and cnf selector.
-// The TAP-backed interconnection is shared for multiple multiplexing punts with the same cnf selector
+// The TAP/veth-backed interconnection is shared for multiple multiplexing punts with the same cnf selector
 // (same network namespace) and vpp selector.
 func (p *hairpinXConnPunt) CanMultiplex() bool {
 	return false
@@ -33,13 +33,13 @@ import (
 	"go.ligato.io/cn-infra/v2/servicelabel"
 
 	"go.ligato.io/vpp-agent/v3/client"
-	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
 	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 	vpp_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
 
+	vpp_ndproxy "go.pantheon.tech/stonework/proto/ndproxy"
 	pb "go.pantheon.tech/stonework/proto/puntmgr"
 )
 
@@ -47,6 +47,15 @@ const (
 	memifSockDir = "/run/stonework/memif"
 )
 
+// SupportedInterconnectTypes lists all types of interconnects that the Interconnect Manager is able to create.
+var SupportedInterconnectTypes = []pb.PuntRequest_InterconnectType{
+	pb.PuntRequest_TAP,
+	pb.PuntRequest_MEMIF,
+	pb.PuntRequest_AF_UNIX,
+	pb.PuntRequest_AF_PACKET,
+	pb.PuntRequest_AF_XDP,
+}
+
 // InterconnectManager manages creation/deletion and sharing of VPP<->CNF/Linux interconnects.
 type InterconnectManager interface {
 	// Add new VPP<->CNF/Linux interconnects needed for a given punt.
@@ -56,9 +65,33 @@ type InterconnectManager interface {
 		icType pb.PuntRequest_InterconnectType, enableGso bool, withMultiplex bool) (interconnects []*pb.PuntMetadata_Interconnect, err error)
 	// Delete all VPP<->CNF/Linux interconnects created for a given punt.
 	DelInterconnects(localTxn, remoteTxn client.ChangeRequest, puntId puntID) (err error)
+	// ResyncRemote prepares configuration of all interconnects of the given CNF on the side of the StoneWork
+	// module (to re-apply it after the module has restarted).
+	ResyncRemote(remoteTxn client.ChangeRequest, cnfMsLabel string)
 	// GetLinuxVrfName returns the name used for Linux VRF device corresponding to the given VPP VRF.
 	// Method is "static" in the sense that it can be called anytime, regardless of the internal state of the Manager.
 	GetLinuxVrfName(vrf uint32) string
+	// Checkpoint returns the part of the Manager state that has to be persisted for the interconnects
+	// to be re-created with the same addresses and interface names after restart.
+	Checkpoint() *InterconnectCheckpoint
+	// Restore preloads state from a checkpoint. Restored allocations are reserved until the interconnects
+	// are re-added. Nil checkpoint drops all restored allocations that were not re-claimed.
+	Restore(cp *InterconnectCheckpoint)
+	// ReconcileRestored verifies restored allocations of the given restored punts against the interconnect
+	// interfaces retrieved from VPP and returns punts whose interconnects do not match.
+	ReconcileRestored(restored []*pb.PuntMetadata) (mismatched []puntID)
+	// GetSubnetUsage returns usage of CIDR pools from which subnets are allocated for interconnects.
+	GetSubnetUsage() []*pb.SubnetPoolUsage
+	// GetSharedInterconnects returns interconnects of the given punt that are shared with other punts.
+	GetSharedInterconnects(puntId puntID) []*pb.PuntInfo_SharedInterconnect
+}
+
+// InterconnectCheckpoint is the persisted state of the InterconnectManager.
+type InterconnectCheckpoint struct {
+	// Indexes of allocated subnets, key = punt ID + VPP selector (see allocKey).
+	AllocSubnets map[string]int `json:"alloc-subnets,omitempty"`
+	// IDs of learned network namespaces (used to generate interface names), key = microservice label.
+	NetNsIDs map[string]int `json:"netns-ids,omitempty"`
 }
 
 // interconnectManager implements InterconnectManager interface.
@@ -68,8 +101,9 @@ type interconnectManager struct {
 	svcLabel servicelabel.ReaderAPI
 	netNsReg NetNsRegistry
 
-	allocCidr       *net.IPNet
-	nextAllocSubnet int
+	subnetAlloc     *subnetAllocator
+	restoredSubnets map[string]int // key = punt ID + VPP selector (see allocKey)
+	withNDProxy     bool           // IPv6 ND proxy is supported by VPP
 
 	icByID          map[icID]*interconnect
 	icByVppSelector map[string][]*interconnect // key = vpp selector
@@ -124,6 +158,7 @@ type interconnect struct {
 	withMultiplex  bool
 	metadata       *pb.PuntMetadata_Interconnect
 	proxyIfaceName string
+	allocdSubnet   bool
 	allocSubnetIdx int
 	usedBy         []puntID
 }
@@ -136,21 +171,161 @@ type proxiedIface struct {
 	proxiedBy []icID
 }
 
-func NewInterconnectManager(log logging.Logger, ifPlugin ifplugin.API, svcLabel servicelabel.ReaderAPI, nsPlugin nsplugin.API,
-	allocCidr *net.IPNet) InterconnectManager {
+func NewInterconnectManager(log logging.Logger, ifPlugin ifplugin.API, svcLabel servicelabel.ReaderAPI, netNsReg NetNsRegistry,
+	allocCidrs []*net.IPNet, allocCidrV6 *net.IPNet, allocPrefixLenV6 int, withNDProxy bool) (InterconnectManager, error) {
+	// interconnects are allocated /30 IPv4 subnets (VPP IP + CNF IP) and optionally /126 or /127 IPv6 subnets
+	subnetAlloc, err := newSubnetAllocator(allocCidrs, 2, allocCidrV6, net.IPv6len*8-allocPrefixLenV6)
+	if err != nil {
+		return nil, err
+	}
 	_ = os.Mkdir(memifSockDir, os.ModeDir)
 	return &interconnectManager{
 		log:             log,
 		ifPlugin:        ifPlugin,
 		svcLabel:        svcLabel,
-		allocCidr:       allocCidr,
-		netNsReg:        NewNetNsRegistry(nsPlugin, svcLabel),
+		subnetAlloc:     subnetAlloc,
+		netNsReg:        netNsReg,
 		icByID:          make(map[icID]*interconnect),
 		icByVppSelector: make(map[string][]*interconnect),
 		icByPuntID:      make(map[puntID][]*interconnect),
 		proxiedIfaces:   make(map[string]*proxiedIface),
 		vrfRefCount:     make(map[vrfID][]*vrfRefCountPerCnf),
+		restoredSubnets: make(map[string]int),
+		withNDProxy:     withNDProxy,
+	}, nil
+}
+
+// Checkpoint returns the part of the Manager state that has to be persisted for the interconnects
+// to be re-created with the same addresses and interface names after restart.
+func (m *interconnectManager) Checkpoint() *InterconnectCheckpoint {
+	cp := &InterconnectCheckpoint{
+		AllocSubnets: make(map[string]int),
+		NetNsIDs:     m.netNsReg.GetNetNsIDs(),
+	}
+	// keep restored allocations that were not yet re-claimed
+	for key, idx := range m.restoredSubnets {
+		cp.AllocSubnets[key] = idx
+	}
+	for puntId, ics := range m.icByPuntID {
+		for _, ic := range ics {
+			if ic.allocdSubnet {
+				cp.AllocSubnets[allocKey(puntId, ic.id.VppSelector)] = ic.allocSubnetIdx
+			}
+		}
+	}
+	return cp
+}
+
+// Restore preloads state from a checkpoint. Restored allocations are reserved until the interconnects
+// are re-added. Nil checkpoint drops all restored allocations that were not re-claimed.
+func (m *interconnectManager) Restore(cp *InterconnectCheckpoint) {
+	for _, idx := range m.restoredSubnets {
+		m.subnetAlloc.release(idx)
+	}
+	m.restoredSubnets = make(map[string]int)
+	if cp == nil {
+		m.netNsReg.ReserveNetNsIDs(nil)
+		return
+	}
+	for key, idx := range cp.AllocSubnets {
+		if !m.subnetAlloc.isFree(idx) {
+			m.log.Warnf("Cannot reserve checkpointed subnet %d for %s: subnet is already allocated "+
+				"or out of range", idx, key)
+			continue
+		}
+		m.subnetAlloc.markUsed(idx)
+		m.restoredSubnets[key] = idx
+	}
+	m.netNsReg.ReserveNetNsIDs(cp.NetNsIDs)
+}
+
+// ReconcileRestored verifies restored allocations of the given restored punts against the interconnect
+// interfaces retrieved from VPP and returns punts whose interconnects do not match. Subnet of the interconnect
+// interface found in VPP replaces the restored allocation (or the allocation is dropped if the subnet cannot
+// be reserved). Interconnects missing in VPP (e.g. VPP has restarted as well) keep the restored allocation.
+func (m *interconnectManager) ReconcileRestored(restored []*pb.PuntMetadata) (mismatched []puntID) {
+	if m.ifPlugin == nil {
+		return nil
+	}
+	ifIndex := m.ifPlugin.GetInterfaceIndex()
+	for _, puntMeta := range restored {
+		puntId := puntIdFromProto(puntMeta)
+		var puntMismatch bool
+		for _, ic := range puntMeta.GetInterconnects() {
+			key := allocKey(puntId, ic.GetId().GetVppSelector())
+			restoredIdx, isRestored := m.restoredSubnets[key]
+			if !isRestored {
+				continue
+			}
+			ifMeta, exists := ifIndex.LookupByName(ic.GetVppInterface().GetName())
+			if !exists || ifMeta == nil {
+				continue
+			}
+			vppIdx, inPool := -1, false
+			for _, ipAddr := range ifMeta.IPAddresses {
+				if ip, _, err := net.ParseCIDR(ipAddr); err == nil {
+					if vppIdx, inPool = m.subnetAlloc.index(ip); inPool {
+						break
+					}
+				}
+			}
+			if inPool && vppIdx == restoredIdx {
+				continue
+			}
+			puntMismatch = true
+			m.subnetAlloc.release(restoredIdx)
+			delete(m.restoredSubnets, key)
+			if inPool && m.subnetAlloc.isFree(vppIdx) {
+				m.log.Warnf("Interconnect %s of punt %v uses subnet %d in VPP instead of the checkpointed subnet %d",
+					ic.GetVppInterface().GetName(), puntId, vppIdx, restoredIdx)
+				m.subnetAlloc.markUsed(vppIdx)
+				m.restoredSubnets[key] = vppIdx
+			} else {
+				m.log.Warnf("Interconnect %s of punt %v does not use the checkpointed subnet %d in VPP, "+
+					"dropping the allocation", ic.GetVppInterface().GetName(), puntId, restoredIdx)
+			}
+		}
+		if puntMismatch {
+			mismatched = append(mismatched, puntId)
+		}
+	}
+	return mismatched
+}
+
+// GetSubnetUsage returns usage of CIDR pools from which subnets are allocated for interconnects.
+func (m *interconnectManager) GetSubnetUsage() []*pb.SubnetPoolUsage {
+	restored := make(map[int]struct{})
+	for _, idx := range m.restoredSubnets {
+		restored[idx] = struct{}{}
+	}
+	return m.subnetAlloc.usage(func(idx int) bool {
+		_, isRestored := restored[idx]
+		return isRestored
+	})
+}
+
+// GetSharedInterconnects returns interconnects of the given punt that are shared with other punts.
+func (m *interconnectManager) GetSharedInterconnects(puntId puntID) (shared []*pb.PuntInfo_SharedInterconnect) {
+	for _, ic := range m.icByPuntID[puntId] {
+		if len(ic.usedBy) < 2 {
+			continue
+		}
+		sharedIC := &pb.PuntInfo_SharedInterconnect{
+			Id: &pb.PuntMetadata_InterconnectID{
+				VppSelector: ic.id.VppSelector,
+				CnfSelector: ic.id.CnfSelector,
+			},
+		}
+		for _, usedBy := range ic.usedBy {
+			sharedIC.UsedBy = append(sharedIC.UsedBy, &pb.PuntID{
+				CnfMsLabel: usedBy.cnfMsLabel,
+				Key:        usedBy.key,
+				Label:      usedBy.label,
+			})
+		}
+		shared = append(shared, sharedIC)
 	}
+	return shared
 }
 
 // Add new VPP<->CNF/Linux interconnects needed for a given punt.
@@ -164,13 +339,19 @@ func (m *interconnectManager) AddInterconnects(localTxn, remoteTxn client.Change
 	var ics []*interconnect
 	proxiedIfaces := make(map[string]*proxiedIface)
 	vppSelectors := make(map[string]struct{})
-	nextAllocSubnet := m.nextAllocSubnet
+	allocdSubnets := make(map[int]struct{}) // allocated by this call
 
 	// 1. build definition of each interconnect and check for conflicts
 	//    without making any changes to any of the internal maps
 	for _, req := range reqs {
+		reqIcType := icType
+		if _, isLinuxCPLink := req.link.(*LinuxCPLink); isLinuxCPLink {
+			// host interface of the linux-cp pair is always TAP
+			reqIcType = pb.PuntRequest_TAP
+		}
+
 		// build interconnect ID
-		cnfSelector, err := m.getCnfSelector(puntId, req, icType)
+		cnfSelector, err := m.getCnfSelector(puntId, req, reqIcType)
 		if err != nil {
 			return nil, err
 		}
@@ -184,19 +365,25 @@ func (m *interconnectManager) AddInterconnects(localTxn, remoteTxn client.Change
 		if _, duplicate := vppSelectors[req.vppSelector]; duplicate {
 			return nil, fmt.Errorf("duplicate VPP selector %s", req.vppSelector)
 		}
-		allocSubnetIdx := nextAllocSubnet
+		var (
+			allocSubnetIdx int
+			sharedSubnet   bool
+		)
 		vppSelectors[req.vppSelector] = struct{}{}
 		for _, ic2 := range m.icByVppSelector[req.vppSelector] {
 			if !withMultiplex || !ic2.withMultiplex {
 				return nil, fmt.Errorf("VPP selector %s is busy", req.vppSelector)
 			}
 			if ic2.metadata.Id.CnfSelector == cnfSelector {
-				if ic2.icType != pb.PuntRequest_TAP || icType != pb.PuntRequest_TAP ||
-					!ic2.request.link.equivalent(req.link) {
+				// tunnel is shared regardless of the (unused) interconnect type
+				_, isTunnel := req.link.(*TunnelLink)
+				sharable := isTunnel || (ic2.icType == reqIcType && isSharableIcType(reqIcType))
+				if !sharable || !ic2.request.link.equivalent(req.link) {
 					return nil, fmt.Errorf("CNF selector %s is busy", cnfSelector)
 				}
 				// it will be shared
 				allocSubnetIdx = ic2.allocSubnetIdx
+				sharedSubnet = true
 			}
 		}
 
@@ -230,43 +417,70 @@ func (m *interconnectManager) AddInterconnects(localTxn, remoteTxn client.Change
 			}
 		}
 
+		// IP addresses of the interface mirrored by linux-cp
+		var mirroredIPs []*net.IPNet
+		if lcpLink, isLinuxCPLink := req.link.(*LinuxCPLink); isLinuxCPLink {
+			mirroredIPs, err = m.getVppIfaceIPs(lcpLink.vppInterface)
+			if err != nil {
+				return nil, fmt.Errorf("failed to mirror VPP interface: %w", err)
+			}
+		}
+
 		// allocate subnet if requested
-		var allocdSubnet *net.IPNet // nil or exactly two host IPs
+		var allocdSubnet4, allocdSubnet6 *net.IPNet // nil or exactly two host IPs
 		if ifLink, isIfLink := req.link.(*InterfaceLink); isIfLink {
 			if ifLink.allocateSubnet {
-				ones, bits := m.allocCidr.Mask.Size()
-				if bits-ones < 2 {
-					return nil, errors.New("failed to allocate subnet for interconnect: " +
-						"cidr for address allocation is too small")
+				if !sharedSubnet {
+					// prefer subnet used before restart
+					idx, found := m.preferredSubnetIdx(puntId, id, req, reqIcType, allocdSubnets)
+					if !found {
+						idx, err = m.subnetAlloc.findFree(allocdSubnets)
+						if err != nil {
+							return nil, fmt.Errorf("failed to allocate subnet for interconnect: %w", err)
+						}
+					}
+					allocSubnetIdx = idx
+					allocdSubnets[allocSubnetIdx] = struct{}{}
 				}
-				allocdSubnet, err = cidr.Subnet(m.allocCidr, bits-ones-2, allocSubnetIdx)
+				allocdSubnet4, allocdSubnet6, err = m.subnetAlloc.subnets(allocSubnetIdx)
 				if err != nil {
 					return nil, fmt.Errorf("failed to allocate subnet for interconnect: %v", err)
 				}
-				if allocSubnetIdx == nextAllocSubnet {
-					nextAllocSubnet++
-				}
 			}
 		}
 
 		// build interconnect definition
-		metadata := m.buildMetadata(puntId, id, icType, req.link, allocdSubnet, proxyIface)
+		metadata := m.buildMetadata(puntId, id, reqIcType, req.link, allocdSubnet4, allocdSubnet6, proxyIface,
+			mirroredIPs)
 		m.log.Debugf("Interconnect metadata: %+v", metadata)
 		ics = append(ics, &interconnect{
 			id:             id,
 			request:        req,
-			icType:         icType,
+			icType:         reqIcType,
 			enableGso:      enableGso,
 			withMultiplex:  withMultiplex,
 			metadata:       metadata,
 			proxyIfaceName: proxyIfaceName,
+			allocdSubnet:   allocdSubnet4 != nil || allocdSubnet6 != nil,
 			allocSubnetIdx: allocSubnetIdx,
 			usedBy:         []puntID{puntId},
 		})
 	}
 
 	// nothing can fail from this point on...
-	m.nextAllocSubnet = nextAllocSubnet
+	for _, req := range reqs {
+		// restored allocations are now re-claimed
+		key := allocKey(puntId, req.vppSelector)
+		if idx, isRestored := m.restoredSubnets[key]; isRestored {
+			delete(m.restoredSubnets, key)
+			if _, reused := allocdSubnets[idx]; !reused {
+				m.subnetAlloc.release(idx)
+			}
+		}
+	}
+	for idx := range allocdSubnets {
+		m.subnetAlloc.markUsed(idx)
+	}
 
 	// update internal maps and prepare transaction
 	for _, ic := range ics {
@@ -352,6 +566,34 @@ func (m *interconnectManager) AddInterconnects(localTxn, remoteTxn client.Change
 	return resp, nil
 }
 
+// ResyncRemote prepares configuration of all interconnects of the given CNF on the side of the StoneWork
+// module (to re-apply it after the module has restarted).
+func (m *interconnectManager) ResyncRemote(remoteTxn client.ChangeRequest, cnfMsLabel string) {
+	// local side of interconnects is already configured, build it into a txn that is never sent
+	localTxn := newPuntChangeRequest(nil)
+	ics := make(map[icID]struct{})
+	vrfs := make(map[vrfID]struct{})
+	for puntId, puntICs := range m.icByPuntID {
+		if puntId.cnfMsLabel != cnfMsLabel {
+			continue
+		}
+		for _, ic := range puntICs {
+			if _, built := ics[ic.id]; !built {
+				ics[ic.id] = struct{}{}
+				m.buildInterconnectTxn(localTxn, remoteTxn, ic, true, false)
+			}
+			if ic.metadata.CnfInterface == nil {
+				continue
+			}
+			vrfID := vrfID{vppVrf: ic.metadata.CnfInterface.VrfRT, CnfSelector: ic.id.CnfSelector}
+			if _, built := vrfs[vrfID]; !built {
+				vrfs[vrfID] = struct{}{}
+				m.buildVrfTxn(localTxn, remoteTxn, ic, true, false)
+			}
+		}
+	}
+}
+
 // Delete all VPP<->CNF/Linux interconnects created for a given punt.
 func (m *interconnectManager) DelInterconnects(localTxn, remoteTxn client.ChangeRequest, puntId puntID) (err error) {
 	ics, hasICs := m.icByPuntID[puntId]
@@ -412,6 +654,9 @@ func (m *interconnectManager) DelInterconnects(localTxn, remoteTxn client.Change
 				delete(m.icByVppSelector, ic.id.VppSelector)
 			}
 			delete(m.icByID, ic.id)
+			if ic.allocdSubnet {
+				m.subnetAlloc.release(ic.allocSubnetIdx)
+			}
 		}
 		if !icSharedWithin {
 			m.buildInterconnectTxn(localTxn, remoteTxn, ic, sharedIC, true)
@@ -443,6 +688,51 @@ func (m *interconnectManager) DelInterconnects(localTxn, remoteTxn client.Change
 	return nil
 }
 
+// preferredSubnetIdx returns index of the subnet that should be preferably allocated for the given interconnect
+// in order to preserve addresses used before restart. Subnet assigned to the interconnect interface found in VPP
+// takes precedence over the subnet restored from the checkpoint.
+func (m *interconnectManager) preferredSubnetIdx(puntId puntID, id icID, req InterconnectReq,
+	icType pb.PuntRequest_InterconnectType, allocdSubnets map[int]struct{}) (idx int, found bool) {
+	key := allocKey(puntId, req.vppSelector)
+	restoredIdx, isRestored := m.restoredSubnets[key]
+	isFree := func(idx int) bool {
+		if _, allocated := allocdSubnets[idx]; allocated {
+			return false
+		}
+		// subnet restored for this interconnect is already marked as used
+		return m.subnetAlloc.isFree(idx) || (isRestored && idx == restoredIdx)
+	}
+	// subnet assigned to the interconnect interface that was retrieved from VPP
+	if ifLink := req.link.(*InterfaceLink); ifLink.interfaceName == "" && m.ifPlugin != nil {
+		ifMeta, exists := m.ifPlugin.GetInterfaceIndex().LookupByName(m.getIcIfaceName(id, icType))
+		if exists && ifMeta != nil {
+			for _, ipAddr := range ifMeta.IPAddresses {
+				ip, _, err := net.ParseCIDR(ipAddr)
+				if err != nil {
+					continue
+				}
+				idx, found = m.subnetAlloc.index(ip)
+				if found && isFree(idx) {
+					m.log.Debugf("Re-using subnet %d found in VPP for interconnect %s", idx, id)
+					return idx, true
+				}
+			}
+		}
+	}
+	// subnet restored from the checkpoint
+	if isRestored && isFree(restoredIdx) {
+		m.log.Debugf("Re-using subnet %d restored from checkpoint for interconnect %s", restoredIdx, id)
+		return restoredIdx, true
+	}
+	return 0, false
+}
+
+// allocKey returns key under which subnet allocation for the given punt and VPP selector is checkpointed.
+// Unlike interconnect ID it does not depend on the order in which network namespaces are learned.
+func allocKey(puntId puntID, vppSelector string) string {
+	return puntId.String() + "|" + vppSelector
+}
+
 func (m *interconnectManager) getCnfSelector(
 	puntId puntID, icReq InterconnectReq, icType pb.PuntRequest_InterconnectType) (string, error) {
 	switch link := icReq.link.(type) {
@@ -463,19 +753,79 @@ func (m *interconnectManager) getCnfSelector(
 				return "", err
 			}
 			return "netns::" + strconv.Itoa(nsId), nil
+		case pb.PuntRequest_AF_PACKET, pb.PuntRequest_AF_XDP:
+			nsId, err := m.netNsReg.GetNetNsID(puntId.cnfMsLabel)
+			if err != nil {
+				err = fmt.Errorf("failed to obtains net-ns ID for microservice %s: %w",
+					puntId.cnfMsLabel, err)
+				return "", err
+			}
+			return strings.ToLower(icType.String()) + "::netns::" + strconv.Itoa(nsId), nil
 		case pb.PuntRequest_AF_UNIX:
 			return "", errors.New("interconnect link/type mismatch")
 		default:
 			return "", errors.New("unrecognized interconnect type")
 		}
+	case *LinuxCPLink:
+		// interconnect type is not used, host interface is created in the network namespace of the CNF
+		nsId, err := m.netNsReg.GetNetNsID(puntId.cnfMsLabel)
+		if err != nil {
+			err = fmt.Errorf("failed to obtain net-ns ID for microservice %s: %w",
+				puntId.cnfMsLabel, err)
+			return "", err
+		}
+		return "linux-cp::netns::" + strconv.Itoa(nsId), nil
+	case *TunnelLink:
+		// interconnect type is not used, tunnel is identified by its endpoints
+		return fmt.Sprintf("tunnel::%s:%s->%s:%d:%d", strings.ToLower(link.tunnelType.String()),
+			link.srcAddress, link.dstAddress, link.outerVrf, link.sessionID), nil
 	}
 	return "", errors.New("unrecognized interconnect link")
 }
 
 func (m *interconnectManager) buildMetadata(
 	puntId puntID, icID icID, icType pb.PuntRequest_InterconnectType, link InterconnectLink,
-	allocdSubnet *net.IPNet, proxyIface *proxiedIface) *pb.PuntMetadata_Interconnect {
-	var vppIface, cnfIface *pb.PuntMetadata_Interface
+	allocdSubnet4, allocdSubnet6 *net.IPNet, proxyIface *proxiedIface,
+	mirroredIPs []*net.IPNet) *pb.PuntMetadata_Interconnect {
+	var (
+		vppIface, cnfIface *pb.PuntMetadata_Interface
+		tunnel             *pb.PuntMetadata_Tunnel
+	)
+	if tunnelLink, isTunnelLink := link.(*TunnelLink); isTunnelLink {
+		vppIface = &pb.PuntMetadata_Interface{
+			Name:  "gre-" + hashString(icID.String(), 5),
+			VrfRT: tunnelLink.outerVrf,
+		}
+		tunnel = &pb.PuntMetadata_Tunnel{
+			TunnelType: tunnelLink.tunnelType,
+			SrcAddress: tunnelLink.srcAddress,
+			DstAddress: tunnelLink.dstAddress,
+			OuterVrf:   tunnelLink.outerVrf,
+			SessionId:  tunnelLink.sessionID,
+		}
+	}
+	if lcpLink, isLinuxCPLink := link.(*LinuxCPLink); isLinuxCPLink {
+		vppIface = &pb.PuntMetadata_Interface{
+			Name:  lcpLink.vppInterface,
+			VrfRT: lcpLink.vrf,
+		}
+		cnfIface = &pb.PuntMetadata_Interface{
+			Name: lcpLink.hostIfName,
+		}
+		if cnfIface.Name == "" {
+			cnfIface.Name = "lcp-" + hashString(icID.String(), 5)
+		}
+		// host interface mirrors the IP addresses of the VPP interface
+		for _, ip := range mirroredIPs {
+			cnfIface.IpAddresses = append(cnfIface.IpAddresses, ip.String())
+		}
+		if !lcpLink.withoutCNFVrf {
+			cnfIface.VrfRT = lcpLink.vrf
+			if lcpLink.vrf != 0 {
+				cnfIface.VrfName = m.GetLinuxVrfName(lcpLink.vrf)
+			}
+		}
+	}
 	if ifLink, isIfLink := link.(*InterfaceLink); isIfLink {
 		vppIface = &pb.PuntMetadata_Interface{}
 		cnfIface = &pb.PuntMetadata_Interface{}
@@ -502,21 +852,21 @@ func (m *interconnectManager) buildMetadata(
 			for _, ip := range proxyIface.ips {
 				cnfIface.IpAddresses = append(cnfIface.IpAddresses, ip.String())
 			}
-		} else if allocdSubnet != nil {
-			vppIP, err := cidr.Host(allocdSubnet, 1)
-			if err != nil {
-				// should be unreachable
-				m.log.Error(err)
-			}
-			vppIPNet := &net.IPNet{IP: vppIP, Mask: allocdSubnet.Mask}
-			cnfIP, err := cidr.Host(allocdSubnet, 2)
-			if err != nil {
-				// should be unreachable
-				m.log.Error(err)
+		} else {
+			// IPv4 address (if any) is listed first
+			for _, allocdSubnet := range []*net.IPNet{allocdSubnet4, allocdSubnet6} {
+				if allocdSubnet == nil {
+					continue
+				}
+				vppIPNet, cnfIPNet, err := subnetHostIPs(allocdSubnet)
+				if err != nil {
+					// should be unreachable
+					m.log.Error(err)
+					continue
+				}
+				vppIface.IpAddresses = append(vppIface.IpAddresses, vppIPNet.String())
+				cnfIface.IpAddresses = append(cnfIface.IpAddresses, cnfIPNet.String())
 			}
-			cnfIPNet := &net.IPNet{IP: cnfIP, Mask: allocdSubnet.Mask}
-			vppIface.IpAddresses = []string{vppIPNet.String()}
-			cnfIface.IpAddresses = []string{cnfIPNet.String()}
 		}
 		// VRF
 		vppIface.VrfRT = ifLink.vrf
@@ -534,12 +884,43 @@ func (m *interconnectManager) buildMetadata(
 		},
 		VppInterface: vppIface,
 		CnfInterface: cnfIface,
+		Tunnel:       tunnel,
 		// .Shared is updated during merge
 	}
 }
 
+// subnetHostIPs returns IP addresses to assign to VPP and CNF side of an interconnect with the given subnet.
+// Point-to-point /31 (IPv4) and /127 (IPv6) subnets have no network or broadcast address to skip.
+func subnetHostIPs(subnet *net.IPNet) (vppIPNet, cnfIPNet *net.IPNet, err error) {
+	firstHost := 1
+	if ones, bits := subnet.Mask.Size(); bits-ones == 1 {
+		firstHost = 0
+	}
+	vppIP, err := cidr.Host(subnet, firstHost)
+	if err != nil {
+		return nil, nil, err
+	}
+	cnfIP, err := cidr.Host(subnet, firstHost+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &net.IPNet{IP: vppIP, Mask: subnet.Mask}, &net.IPNet{IP: cnfIP, Mask: subnet.Mask}, nil
+}
+
 // buildInterconnectTxn prepares items to configure locally as well as remotely in order to build VPP<->CNF interconnect.
 func (m *interconnectManager) buildInterconnectTxn(localTxn, remoteTxn client.ChangeRequest, ic *interconnect, sharedIC, remove bool) {
+	if _, isTunnel := ic.request.link.(*TunnelLink); isTunnel {
+		// Only the VPP side of the interconnect
+		if !sharedIC {
+			m.buildTunnelTxn(localTxn, ic, remove)
+		}
+		return
+	}
+	if _, isLinuxCP := ic.request.link.(*LinuxCPLink); isLinuxCP {
+		// linux-cp pair itself is configured by the punt handler
+		m.buildLinuxCPTxn(localTxn, remoteTxn, ic, sharedIC, remove)
+		return
+	}
 	switch ic.icType {
 	case pb.PuntRequest_AF_UNIX:
 		// Nothing to configure between VPP and CNF
@@ -593,6 +974,7 @@ func (m *interconnectManager) buildInterconnectTxn(localTxn, remoteTxn client.Ch
 			} else {
 				localTxn.Update(vppIface)
 			}
+			m.buildNDProxyTxn(localTxn, ic, remove)
 		}
 		// Linux side of the interconnect
 		var linuxNs *linux_namespace.NetNamespace
@@ -640,6 +1022,9 @@ func (m *interconnectManager) buildInterconnectTxn(localTxn, remoteTxn client.Ch
 			}
 		}
 
+	case pb.PuntRequest_AF_PACKET, pb.PuntRequest_AF_XDP:
+		m.buildVethTxn(localTxn, remoteTxn, ic, sharedIC, remove)
+
 	case pb.PuntRequest_MEMIF:
 		memifSufix := m.getMemifSuffix(ic.usedBy[0], ic.metadata.Id.VppSelector)
 		memifSockPath := path.Join(memifSockDir, "memif-"+memifSufix+".sock")
@@ -677,6 +1062,7 @@ func (m *interconnectManager) buildInterconnectTxn(localTxn, remoteTxn client.Ch
 		} else {
 			localTxn.Update(vppIface)
 		}
+		m.buildNDProxyTxn(localTxn, ic, remove)
 		// CNF side of the interconnect
 		cnfIface := &vpp_interfaces.Interface{
 			Name:        ic.metadata.CnfInterface.Name,
@@ -705,15 +1091,219 @@ func (m *interconnectManager) buildInterconnectTxn(localTxn, remoteTxn client.Ch
 	return
 }
 
+// buildVethTxn (un)configures interconnect made of a veth pair, with one end inside the network namespace
+// of the CNF and the other end attached to VPP as AF_PACKET or AF_XDP interface.
+func (m *interconnectManager) buildVethTxn(localTxn, remoteTxn client.ChangeRequest, ic *interconnect, sharedIC, remove bool) {
+	// get the ms label designated to reference this network namespace
+	nsID, err := m.netNsReg.GetNetNsID(ic.usedBy[0].cnfMsLabel)
+	isLocalCnf := nsID == 0
+	if err != nil {
+		// this should be unreachable
+		m.log.Error(err)
+	}
+	var msLabel string
+	if !isLocalCnf {
+		msLabel, err = m.netNsReg.GetNetNsLabel(nsID)
+		if err != nil {
+			// this should be unreachable
+			m.log.Error(err)
+		}
+	}
+	// handle unnumbered interface
+	link := ic.request.link.(*InterfaceLink)
+	var unnumbered *vpp_interfaces.Interface_Unnumbered
+	if link.unnumberedToIface != "" {
+		unnumbered = &vpp_interfaces.Interface_Unnumbered{
+			InterfaceWithIp: link.unnumberedToIface,
+		}
+	}
+	// veth pair, VPP-facing end stays in the network namespace of VPP
+	var linuxNs *linux_namespace.NetNamespace
+	if !isLocalCnf {
+		linuxNs = &linux_namespace.NetNamespace{
+			Type:      linux_namespace.NetNamespace_MICROSERVICE,
+			Reference: msLabel,
+		}
+	}
+	vethName := m.getVethPeerName(ic.metadata.VppInterface.Name)
+	vppVeth := &linux_interfaces.Interface{
+		Name:       vethName,
+		Type:       linux_interfaces.Interface_VETH,
+		HostIfName: vethName,
+		Enabled:    true,
+		Mtu:        link.mtu,
+		Link: &linux_interfaces.Interface_Veth{
+			Veth: &linux_interfaces.VethLink{
+				PeerIfName: ic.metadata.CnfInterface.Name,
+			},
+		},
+	}
+	cnfVeth := &linux_interfaces.Interface{
+		Name:        ic.metadata.CnfInterface.Name,
+		Type:        linux_interfaces.Interface_VETH,
+		Namespace:   linuxNs,
+		Enabled:     true,
+		IpAddresses: ic.metadata.CnfInterface.IpAddresses,
+		PhysAddress: ic.metadata.CnfInterface.PhysAddress,
+		Mtu:         link.mtu,
+		Link: &linux_interfaces.Interface_Veth{
+			Veth: &linux_interfaces.VethLink{
+				PeerIfName: vppVeth.Name,
+			},
+		},
+		VrfMasterInterface: ic.metadata.CnfInterface.VrfName,
+	}
+	// VPP side of the interconnect
+	vppIface := &vpp_interfaces.Interface{
+		Name:          ic.metadata.VppInterface.Name,
+		Enabled:       true,
+		PhysAddress:   ic.metadata.VppInterface.PhysAddress,
+		IpAddresses:   ic.metadata.VppInterface.IpAddresses,
+		Vrf:           ic.metadata.VppInterface.VrfRT,
+		SetDhcpClient: link.withDhcpClient,
+		Mtu:           link.mtu,
+		Unnumbered:    unnumbered,
+	}
+	if ic.icType == pb.PuntRequest_AF_XDP {
+		vppIface.Type = vpp_interfaces.Interface_AF_XDP
+		vppIface.Link = &vpp_interfaces.Interface_Afxdp{
+			Afxdp: &vpp_interfaces.AFXDPLink{
+				LinuxInterface: vethName,
+				RxqNum:         1, // veth has a single queue by default
+			},
+		}
+	} else {
+		vppIface.Type = vpp_interfaces.Interface_AF_PACKET
+		vppIface.Link = &vpp_interfaces.Interface_Afpacket{
+			Afpacket: &vpp_interfaces.AfpacketLink{
+				LinuxInterface: vethName,
+			},
+		}
+	}
+	if !sharedIC {
+		if remove {
+			localTxn.Delete(vppVeth, cnfVeth, vppIface)
+		} else {
+			localTxn.Update(vppVeth, cnfVeth, vppIface)
+		}
+		m.buildNDProxyTxn(localTxn, ic, remove)
+	}
+	if !isLocalCnf {
+		existingLinuxIface := &linux_interfaces.Interface{
+			Name:               cnfVeth.Name,
+			Type:               linux_interfaces.Interface_EXISTING,
+			Enabled:            true,
+			IpAddresses:        cnfVeth.IpAddresses,
+			LinkOnly:           true, // wait for IP addresses, do not configure them
+			VrfMasterInterface: cnfVeth.VrfMasterInterface,
+		}
+		if remove {
+			remoteTxn.Delete(existingLinuxIface)
+		} else {
+			remoteTxn.Update(existingLinuxIface)
+		}
+	}
+}
+
+// buildTunnelTxn (un)configures tunnel from VPP to a remote destination.
+func (m *interconnectManager) buildTunnelTxn(localTxn client.ChangeRequest, ic *interconnect, remove bool) {
+	greLink := &vpp_interfaces.GreLink{
+		TunnelType: vpp_interfaces.GreLink_ERSPAN,
+		SrcAddr:    ic.metadata.Tunnel.SrcAddress,
+		DstAddr:    ic.metadata.Tunnel.DstAddress,
+		OuterFibId: ic.metadata.Tunnel.OuterVrf,
+		SessionId:  ic.metadata.Tunnel.SessionId,
+	}
+	if ic.metadata.Tunnel.TunnelType == pb.PuntRequest_RemoteSpan_GRE_TEB {
+		greLink.TunnelType = vpp_interfaces.GreLink_TEB
+	}
+	tunnelIface := &vpp_interfaces.Interface{
+		Name:    ic.metadata.VppInterface.Name,
+		Type:    vpp_interfaces.Interface_GRE_TUNNEL,
+		Enabled: true,
+		Link: &vpp_interfaces.Interface_Gre{
+			Gre: greLink,
+		},
+	}
+	if remove {
+		localTxn.Delete(tunnelIface)
+	} else {
+		localTxn.Update(tunnelIface)
+	}
+}
+
+// buildLinuxCPTxn (un)configures the host interface of a linux-cp pair, which is created by VPP in the network
+// namespace of the CNF. Host interface is configured by the CNF itself (unless it is this CNF) so that its IP
+// addresses are only assigned once it appears in the namespace.
+func (m *interconnectManager) buildLinuxCPTxn(localTxn, remoteTxn client.ChangeRequest, ic *interconnect, sharedIC, remove bool) {
+	nsID, err := m.netNsReg.GetNetNsID(ic.usedBy[0].cnfMsLabel)
+	if err != nil {
+		// this should be unreachable
+		m.log.Error(err)
+	}
+	isLocalCnf := nsID == 0
+	hostIface := &linux_interfaces.Interface{
+		Name:               ic.metadata.CnfInterface.Name,
+		Type:               linux_interfaces.Interface_EXISTING,
+		Enabled:            true,
+		IpAddresses:        ic.metadata.CnfInterface.IpAddresses,
+		VrfMasterInterface: ic.metadata.CnfInterface.VrfName,
+	}
+	txn := remoteTxn
+	if isLocalCnf {
+		if sharedIC {
+			return
+		}
+		txn = localTxn
+	}
+	if remove {
+		txn.Delete(hostIface)
+	} else {
+		txn.Update(hostIface)
+	}
+}
+
+// buildNDProxyTxn (un)configures NDP proxy on the VPP side of an interconnect with unnumbered interface
+// proxying IPv6 network. This is the IPv6 counterpart of the proxy ARP (see rebuildProxyArp).
+func (m *interconnectManager) buildNDProxyTxn(localTxn client.ChangeRequest, ic *interconnect, remove bool) {
+	link := ic.request.link.(*InterfaceLink)
+	if link.unnumberedToIface == "" || !hasIPv6Address(ic.metadata.CnfInterface.IpAddresses) {
+		return
+	}
+	ndProxy := &vpp_ndproxy.NDProxyInterface{
+		Interface: ic.metadata.VppInterface.Name,
+	}
+	if remove {
+		localTxn.Delete(ndProxy)
+	} else {
+		localTxn.Update(ndProxy)
+	}
+}
+
+// hasIPv6Address returns true if at least one of the given addresses (with or without mask) is IPv6.
+func hasIPv6Address(ipAddresses []string) bool {
+	for _, ipAddr := range ipAddresses {
+		ip := net.ParseIP(strings.SplitN(ipAddr, "/", 2)[0])
+		if ip != nil && ip.To4() == nil {
+			return true
+		}
+	}
+	return false
+}
+
 // buildVrfTxn prepares items to configure locally as well as remotely in order to replicate VPP VRFs in Linux.
 func (m *interconnectManager) buildVrfTxn(localTxn, remoteTxn client.ChangeRequest, ic *interconnect, sharedVRF, remove bool) {
+	if ic.metadata.CnfInterface == nil {
+		// no CNF side (e.g. tunnel to a remote destination)
+		return
+	}
 	switch ic.icType {
 	case pb.PuntRequest_AF_UNIX:
 		fallthrough
 	case pb.PuntRequest_MEMIF:
 		// Linux VRF devices not used (CNF may represent VRF inside in its own way)
 		return
-	case pb.PuntRequest_TAP:
+	case pb.PuntRequest_TAP, pb.PuntRequest_AF_PACKET, pb.PuntRequest_AF_XDP:
 		if ic.metadata.CnfInterface.VrfName == "" {
 			// VPP VRF 0 = default routing table in Linux (which always exists)
 			return
@@ -792,6 +1382,7 @@ func (m *interconnectManager) rebuildProxyArp(localTxn client.ChangeRequest) {
 		}
 		for _, ipNet := range proxyIface.ips {
 			if ipNet.IP.To4() == nil {
+				// IPv6 is handled by NDP proxy enabled on the interconnect (see buildNDProxyTxn)
 				continue
 			}
 			network := &net.IPNet{
@@ -813,18 +1404,34 @@ func (m *interconnectManager) rebuildProxyArp(localTxn client.ChangeRequest) {
 }
 
 func (m *interconnectManager) buildProxyInterface(name string, interconnectID icID) (*proxiedIface, error) {
-	ifMeta, exists := m.ifPlugin.GetInterfaceIndex().LookupByName(name)
-	if !exists || ifMeta == nil {
-		return nil, fmt.Errorf(
-			"VPP interface %s required for proxying was not found", name)
+	ips, err := m.getVppIfaceIPs(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to proxy VPP interface: %w", err)
 	}
-	if len(ifMeta.IPAddresses) == 0 {
-		return nil, fmt.Errorf(
-			"VPP interface %s required for proxying does not have any IP address assigned", name)
+	if !m.withNDProxy {
+		// without ND proxy the IPv6 network would appear to be proxied, but neighbour discovery would not work
+		for _, ip := range ips {
+			if ip.IP.To4() == nil {
+				return nil, fmt.Errorf("cannot proxy IPv6 network %v of VPP interface %s: "+
+					"IPv6 ND proxy is not supported by VPP", ip, name)
+			}
+		}
 	}
-	proxyIface := &proxiedIface{
+	return &proxiedIface{
 		name:      name,
+		ips:       ips,
 		proxiedBy: []icID{interconnectID},
+	}, nil
+}
+
+// getVppIfaceIPs returns IP addresses assigned to the given VPP interface (at least one is required).
+func (m *interconnectManager) getVppIfaceIPs(name string) (ips []*net.IPNet, err error) {
+	ifMeta, exists := m.ifPlugin.GetInterfaceIndex().LookupByName(name)
+	if !exists || ifMeta == nil {
+		return nil, fmt.Errorf("VPP interface %s was not found", name)
+	}
+	if len(ifMeta.IPAddresses) == 0 {
+		return nil, fmt.Errorf("VPP interface %s does not have any IP address assigned", name)
 	}
 	for _, ip := range ifMeta.IPAddresses {
 		ipAddr, ipNet, err := net.ParseCIDR(ip)
@@ -838,9 +1445,9 @@ func (m *interconnectManager) buildProxyInterface(name string, interconnectID ic
 			ipAddr = ipAddr.To16()
 		}
 		ipNet.IP = ipAddr
-		proxyIface.ips = append(proxyIface.ips, ipNet)
+		ips = append(ips, ipNet)
 	}
-	return proxyIface, nil
+	return ips, nil
 }
 
 // getMemifSuffix returns suffix to use for memif socket, secret and also as a CNF selector
@@ -856,6 +1463,12 @@ func (m *interconnectManager) getIcIfaceName(ic icID, icType pb.PuntRequest_Inte
 	return prefix + suffix
 }
 
+// getVethPeerName returns the name for the VPP-facing end of the veth pair used by AF_PACKET and AF_XDP
+// interconnects (the other end, inside the CNF, has the name of the interconnect interface).
+func (m *interconnectManager) getVethPeerName(icIfaceName string) string {
+	return "veth-" + hashString(icIfaceName, 5)
+}
+
 // GetLinuxVrfName returns the name used for Linux VRF device corresponding to the given VPP VRF.
 // Method is "static" in the sense that it can be called anytime, regardless of the internal state of the plugin.
 func (m *interconnectManager) GetLinuxVrfName(vrf uint32) string {
@@ -881,6 +1494,16 @@ func (m *interconnectManager) getIcIfaceMAC(icIfaceName string, vppSide bool) st
 	return hwAddr.String()
 }
 
+// isSharableIcType returns true if interconnect of the given type can be shared by multiplexing punts
+// (i.e. it delivers packets into the Linux network stack).
+func isSharableIcType(icType pb.PuntRequest_InterconnectType) bool {
+	switch icType {
+	case pb.PuntRequest_TAP, pb.PuntRequest_AF_PACKET, pb.PuntRequest_AF_XDP:
+		return true
+	}
+	return false
+}
+
 // hashString returns a hash of an arbitrarily long string.
 // The hash will have <len> characters (shouldn't be more than 7).
 func hashString(str string, len int) string {
@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puntmgr
+
+import (
+	"fmt"
+
+	"go.ligato.io/vpp-agent/v3/client"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	vpp_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	vpp_punt "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/punt"
+
+	pb "go.pantheon.tech/stonework/proto/puntmgr"
+)
+
+// ipRedirectPunt implements PuntHandler for PuntRequest_IP_REDIRECT
+type ipRedirectPunt struct {
+	// IP redirect Punt handler needs in-memory cache for the sake of multiplexing,
+	// key = VPP-side interface of the interconnect
+	ipRedirectPunts map[string][]ipRedirectPuntMeta
+}
+
+type ipRedirectPuntMeta struct {
+	puntId  puntID
+	puntReq *pb.PuntRequest
+}
+
+func NewIPRedirectPuntHandler() PuntHandler {
+	return &ipRedirectPunt{
+		ipRedirectPunts: make(map[string][]ipRedirectPuntMeta),
+	}
+}
+
+// IPRedirectSelector selects packets punted from the given VPP interface (from any interface if empty).
+// Punted packets of the same interface (and IP version) can be redirected only to a single interconnect.
+func IPRedirectSelector(ifaceName string) string {
+	if ifaceName == "" {
+		return "vpp/ip-redirect/any-interface"
+	}
+	return "vpp/ip-redirect/interface/" + ifaceName
+}
+
+// GetInterconnectReqs returns definitions of all interconnects which are required between VPP and CNF
+// for this punt request.
+func (p *ipRedirectPunt) GetInterconnectReqs(punt *pb.PuntRequest) []InterconnectReq {
+	if ipRedirect := punt.GetIpRedirect(); ipRedirect != nil {
+		return []InterconnectReq{
+			{
+				link: &InterfaceLink{
+					vrf:            ipRedirect.Vrf,
+					withoutCNFVrf:  ipRedirect.WithoutCnfVrf,
+					allocateSubnet: true,
+				},
+				vppSelector: IPRedirectSelector(ipRedirect.VppInterface),
+			},
+		}
+	}
+	return nil
+}
+
+// GetPuntDependencies returns dependencies that have to be satisfied before the punt can be added.
+func (p *ipRedirectPunt) GetPuntDependencies(punt *pb.PuntRequest) (deps []kvs.Dependency) {
+	if ipRedirect := punt.GetIpRedirect(); ipRedirect != nil {
+		if ipRedirect.GetVppInterface() != "" {
+			deps = append(deps, kvs.Dependency{
+				Label: punt.GetLabel() + "-ip-redirect-" + ipRedirect.GetVppInterface(),
+				Key:   vpp_interfaces.InterfaceKey(ipRedirect.GetVppInterface()),
+			})
+		}
+		if vrf := ipRedirect.GetVrf(); vrf != 0 {
+			forIPv4, forIPv6 := ipRedirectVersions(ipRedirect)
+			if forIPv4 {
+				deps = append(deps, kvs.Dependency{
+					Label: fmt.Sprintf("%s-ip-redirect-vrf-v4-%d", punt.GetLabel(), vrf),
+					Key:   vpp_l3.VrfTableKey(vrf, vpp_l3.VrfTable_IPV4),
+				})
+			}
+			if forIPv6 {
+				deps = append(deps, kvs.Dependency{
+					Label: fmt.Sprintf("%s-ip-redirect-vrf-v6-%d", punt.GetLabel(), vrf),
+					Key:   vpp_l3.VrfTableKey(vrf, vpp_l3.VrfTable_IPV6),
+				})
+			}
+		}
+	}
+	return deps
+}
+
+// CanMultiplex enables interconnection multiplexing for this punting. It could be enabled in certain cases:
+// 1. two or more punts of this type can coexist even if they have the same vpp selector
+// 2. one or more punts of this type can coexist with other type of punts on the same (TAP, AF_PACKET or AF_XDP)
+// interconnection if they all have the same vpp selector and cnf selector.
+// The TAP/veth-backed interconnection is shared for multiple multiplexing punts with the same cnf selector
+// (same network namespace) and vpp selector.
+// Punts with the same vpp selector but different cnf selector can coexist only if they redirect different
+// IP versions (checked by ConfigurePunt).
+func (p *ipRedirectPunt) CanMultiplex() bool {
+	return true
+}
+
+// ConfigurePunt prepares txn to (un)configures VPP-side of the punt.
+// Punts sharing the same interconnect are served by the same redirects, which cover the union of IP versions
+// requested by these punts.
+func (p *ipRedirectPunt) ConfigurePunt(txn client.ChangeRequest, puntId puntID, puntReq *pb.PuntRequest,
+	interconnects []*pb.PuntMetadata_Interconnect, remove bool) error {
+
+	interconnect := interconnects[0]
+	icIface := interconnect.VppInterface.Name
+	rxIface := puntReq.GetIpRedirect().GetVppInterface()
+	cnfIPs := ipAddrsByVersion(interconnect.CnfInterface.IpAddresses)
+
+	if !remove {
+		forIPv4, forIPv6 := ipRedirectVersions(puntReq.GetIpRedirect())
+		for _, ipv6 := range []bool{false, true} {
+			if (!ipv6 && !forIPv4) || (ipv6 && !forIPv6) {
+				continue
+			}
+			if cnfIPs[ipv6] == "" {
+				return fmt.Errorf("interconnect %s has no %s address to redirect punted packets to "+
+					"(is the subnet allocation configured for this IP version?)", icIface, ipVersionName(ipv6))
+			}
+			// punted packets can be redirected only to a single interconnect
+			for icIface2 := range p.ipRedirectPunts {
+				if icIface2 == icIface {
+					continue
+				}
+				other4, other6 := p.getVersions(icIface2, rxIface)
+				if (!ipv6 && other4) || (ipv6 && other6) {
+					return fmt.Errorf("%s packets punted from %s are already redirected to interconnect %s",
+						ipVersionName(ipv6), rxIfaceName(rxIface), icIface2)
+				}
+			}
+		}
+	}
+
+	// IP versions redirected before this change
+	prev4, prev6 := p.getVersions(icIface, rxIface)
+
+	// update the in-memory cache used for multiplexing
+	if remove {
+		var filtered []ipRedirectPuntMeta
+		for _, ipRedirect := range p.ipRedirectPunts[icIface] {
+			if ipRedirect.puntId != puntId {
+				filtered = append(filtered, ipRedirect)
+			}
+		}
+		if len(filtered) == 0 {
+			delete(p.ipRedirectPunts, icIface)
+		} else {
+			p.ipRedirectPunts[icIface] = filtered
+		}
+	} else {
+		p.ipRedirectPunts[icIface] = append(p.ipRedirectPunts[icIface],
+			ipRedirectPuntMeta{
+				puntId:  puntId,
+				puntReq: puntReq,
+			})
+	}
+
+	new4, new6 := p.getVersions(icIface, rxIface)
+	for _, ipv6 := range []bool{false, true} {
+		prev, curr := prev4, new4
+		l3Proto := vpp_punt.L3Protocol_IPV4
+		if ipv6 {
+			prev, curr = prev6, new6
+			l3Proto = vpp_punt.L3Protocol_IPV6
+		}
+		if prev == curr {
+			continue
+		}
+		ipRedirect := &vpp_punt.IPRedirect{
+			L3Protocol:  l3Proto,
+			RxInterface: rxIface,
+			TxInterface: icIface,
+			NextHop:     cnfIPs[ipv6],
+		}
+		if curr {
+			txn.Update(ipRedirect)
+		} else {
+			txn.Delete(ipRedirect)
+		}
+	}
+	return nil
+}
+
+// getVersions returns IP versions of packets punted from the given VPP interface that are redirected
+// to the given interconnect.
+func (p *ipRedirectPunt) getVersions(icIface, rxIface string) (forIPv4, forIPv6 bool) {
+	for _, ipRedirect := range p.ipRedirectPunts[icIface] {
+		if ipRedirect.puntReq.GetIpRedirect().GetVppInterface() != rxIface {
+			continue
+		}
+		v4, v6 := ipRedirectVersions(ipRedirect.puntReq.GetIpRedirect())
+		forIPv4 = forIPv4 || v4
+		forIPv6 = forIPv6 || v6
+	}
+	return forIPv4, forIPv6
+}
+
+// ipRedirectVersions returns IP versions of packets to redirect.
+func ipRedirectVersions(ipRedirect *pb.PuntRequest_IpRedirect) (forIPv4, forIPv6 bool) {
+	switch ipRedirect.GetL3Protocol() {
+	case pb.PuntRequest_IpRedirect_IPV4:
+		return true, false
+	case pb.PuntRequest_IpRedirect_IPV6:
+		return false, true
+	}
+	return true, true
+}
+
+func ipVersionName(ipv6 bool) string {
+	if ipv6 {
+		return "IPv6"
+	}
+	return "IPv4"
+}
+
+func rxIfaceName(rxIface string) string {
+	if rxIface == "" {
+		return "any interface"
+	}
+	return "interface " + rxIface
+}
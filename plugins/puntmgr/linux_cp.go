@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puntmgr
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.ligato.io/vpp-agent/v3/client"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+
+	vpp_linuxcp "go.pantheon.tech/stonework/proto/linuxcp"
+	pb "go.pantheon.tech/stonework/proto/puntmgr"
+)
+
+// linux-cp limits the length of the host interface name to the maximum length of Linux interface name
+const maxHostIfNameLen = 15
+
+// linuxCPPunt implements PuntHandler for PuntRequest_LINUX_CP
+type linuxCPPunt struct {
+	netNsReg NetNsRegistry
+}
+
+func NewLinuxCPPuntHandler(netNsReg NetNsRegistry) PuntHandler {
+	return &linuxCPPunt{
+		netNsReg: netNsReg,
+	}
+}
+
+// GetInterconnectReqs returns definitions of all interconnects which are required between VPP and CNF
+// for this punt request.
+func (p *linuxCPPunt) GetInterconnectReqs(punt *pb.PuntRequest) []InterconnectReq {
+	if linuxCp := punt.GetLinuxCp(); linuxCp != nil {
+		return []InterconnectReq{
+			{
+				link: &LinuxCPLink{
+					vppInterface:  linuxCp.VppInterface,
+					hostIfName:    linuxCp.HostInterface,
+					vrf:           linuxCp.Vrf,
+					withoutCNFVrf: linuxCp.WithoutCnfVrf,
+				},
+				// Selector = interface name, i.e. same as used by ABX, Hairpin and Hairpin XConnect, all of which
+				// are mutually exclusive with linux-cp.
+				vppSelector: VppInterfaceSelector(linuxCp.VppInterface),
+			},
+		}
+	}
+	return nil
+}
+
+// GetPuntDependencies returns dependencies that have to be satisfied before the punt can be added.
+func (p *linuxCPPunt) GetPuntDependencies(punt *pb.PuntRequest) (deps []kvs.Dependency) {
+	// L3 VPP interface
+	if linuxCp := punt.GetLinuxCp(); linuxCp != nil {
+		deps = append(deps,
+			kvs.Dependency{
+				Label: punt.GetLabel() + "-linux-cp-" + linuxCp.GetVppInterface(),
+				AnyOf: kvs.AnyOfDependency{
+					KeyPrefixes: []string{vpp_interfaces.InterfaceAddressPrefix(linuxCp.GetVppInterface())},
+				},
+			})
+		if linuxCp.GetVrf() != 0 {
+			// interface is inside the VRF (irrelevant whether it is IPv4 or IPv6 VRF)
+			deps = append(deps, kvs.Dependency{
+				Label: fmt.Sprintf("%s-linux-cp-vrf-%d", punt.GetLabel(), linuxCp.GetVrf()),
+				AnyOf: kvs.AnyOfDependency{
+					KeyPrefixes: []string{
+						vpp_interfaces.InterfaceVrfKeyPrefix(linuxCp.GetVppInterface()) + strconv.Itoa(int(linuxCp.GetVrf())),
+					},
+				},
+			})
+		}
+	}
+	return
+}
+
+// CanMultiplex enables interconnection multiplexing for this punting.
+// VPP interface can be mirrored by linux-cp only once.
+func (p *linuxCPPunt) CanMultiplex() bool {
+	return false
+}
+
+// ConfigurePunt prepares txn to (un)configures VPP-side of the punt.
+// The VPP side of the punt is the linux-cp pair, which creates the host interface directly
+// in the network namespace of the CNF.
+func (p *linuxCPPunt) ConfigurePunt(txn client.ChangeRequest, puntId puntID, puntReq *pb.PuntRequest,
+	interconnects []*pb.PuntMetadata_Interconnect, remove bool) error {
+
+	interconnect := interconnects[0]
+	lcpPair := &vpp_linuxcp.LinuxCPInterfacePair{
+		Interface:  interconnect.VppInterface.Name,
+		HostIfName: interconnect.CnfInterface.Name,
+		HostIfType: vpp_linuxcp.LinuxCPInterfacePair_TAP,
+	}
+	if remove {
+		txn.Delete(lcpPair)
+		return nil
+	}
+
+	if len(lcpPair.HostIfName) > maxHostIfNameLen {
+		return fmt.Errorf("host interface name %s is longer than %d characters",
+			lcpPair.HostIfName, maxHostIfNameLen)
+	}
+	// linux-cp opens the network namespace by its name
+	netNsName, err := p.netNsReg.GetNetNsName(puntId.cnfMsLabel)
+	if err != nil {
+		return fmt.Errorf("failed to name network namespace of microservice %s for linux-cp: %w",
+			puntId.cnfMsLabel, err)
+	}
+	lcpPair.Netns = netNsName
+	txn.Update(lcpPair)
+	return nil
+}
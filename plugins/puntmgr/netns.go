@@ -17,14 +17,27 @@
 package puntmgr
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
 	"github.com/vishvananda/netns"
 	"go.ligato.io/cn-infra/v2/servicelabel"
+	"golang.org/x/sys/unix"
 
 	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
 	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 )
 
+const (
+	// directory with named network namespaces (as used by "ip netns")
+	netNsRunDir = "/var/run/netns"
+	// prefix of the names given to network namespaces of CNFs
+	netNsNamePrefix = "stonework-ns"
+)
+
 // NetNsRegistry keeps track of all network namespaces used by CNFs.
 type NetNsRegistry interface {
 	// Get ID representing network namespace referenced by a given microservice label.
@@ -35,6 +48,25 @@ type NetNsRegistry interface {
 	// Each learned network namespace is used by one or more microservices. Label of one of these
 	// microservices is designated to represent the namespace.
 	GetNetNsLabel(id int) (msLabel string, err error)
+
+	// GetNetNsIDs returns IDs of all learned (and reserved) network namespaces, key = ms label.
+	GetNetNsIDs() map[string]int
+
+	// ReserveNetNsIDs reserves IDs for network namespaces that are yet to be learned (e.g. after restart).
+	// Nil map releases all reservations.
+	ReserveNetNsIDs(ids map[string]int)
+
+	// GetNetNsName returns name under which the network namespace referenced by a given microservice label
+	// is available in /var/run/netns (as if it was created by "ip netns"). The namespace is bind-mounted there
+	// on the first call. Empty name is returned for the namespace of this CNF.
+	GetNetNsName(msLabel string) (string, error)
+
+	// ReleaseNetNsName unmounts the name given by GetNetNsName to the network namespace referenced
+	// by a given microservice label (if there is any), e.g. once the namespace is no longer used by linux-cp.
+	ReleaseNetNsName(msLabel string) error
+
+	// Close unmounts names of all network namespaces given by GetNetNsName.
+	Close() error
 }
 
 type netNsRegistry struct {
@@ -42,6 +74,8 @@ type netNsRegistry struct {
 	serviceLabel servicelabel.ReaderAPI
 	nsByLabel    map[string]netNs // key = ms label
 	nsById       map[int]string   // key = id, value = designated ms label
+	reservedIds  map[string]int   // key = ms label
+	named        map[int]string   // key = id, value = name in netNsRunDir
 }
 
 type netNs struct {
@@ -55,6 +89,8 @@ func NewNetNsRegistry(nsPlugin nsplugin.API, serviceLabel servicelabel.ReaderAPI
 		serviceLabel: serviceLabel,
 		nsByLabel:    make(map[string]netNs),
 		nsById:       make(map[int]string),
+		reservedIds:  make(map[string]int),
+		named:        make(map[int]string),
 	}
 }
 
@@ -88,9 +124,19 @@ func (r *netNsRegistry) GetNetNsID(msLabel string) (id int, err error) {
 	}
 	if id == 0 {
 		// new namespace
-		id = maxId + 1
+		if reservedId, reserved := r.reservedIds[msLabel]; reserved && r.nsById[reservedId] == "" {
+			id = reservedId
+		} else {
+			for _, reservedId := range r.reservedIds {
+				if reservedId > maxId {
+					maxId = reservedId
+				}
+			}
+			id = maxId + 1
+		}
 		r.nsById[id] = msLabel
 	}
+	delete(r.reservedIds, msLabel)
 	r.nsByLabel[msLabel] = netNs{
 		id:       id,
 		nsHandle: nsHandle,
@@ -110,3 +156,109 @@ func (r *netNsRegistry) GetNetNsLabel(id int) (msLabel string, err error) {
 	}
 	return msLabel, nil
 }
+
+// GetNetNsIDs returns IDs of all learned (and reserved) network namespaces, key = ms label.
+func (r *netNsRegistry) GetNetNsIDs() map[string]int {
+	ids := make(map[string]int)
+	for msLabel, id := range r.reservedIds {
+		ids[msLabel] = id
+	}
+	for msLabel, ns := range r.nsByLabel {
+		ids[msLabel] = ns.id
+	}
+	return ids
+}
+
+// ReserveNetNsIDs reserves IDs for network namespaces that are yet to be learned (e.g. after restart).
+// Nil map releases all reservations.
+func (r *netNsRegistry) ReserveNetNsIDs(ids map[string]int) {
+	r.reservedIds = make(map[string]int)
+	for msLabel, id := range ids {
+		if _, known := r.nsByLabel[msLabel]; !known {
+			r.reservedIds[msLabel] = id
+		}
+	}
+}
+
+// GetNetNsName returns name under which the network namespace referenced by a given microservice label
+// is available in /var/run/netns (as if it was created by "ip netns"). The namespace is bind-mounted there
+// on the first call. Empty name is returned for the namespace of this CNF.
+func (r *netNsRegistry) GetNetNsName(msLabel string) (string, error) {
+	id, err := r.GetNetNsID(msLabel)
+	if err != nil || id == 0 {
+		return "", err
+	}
+	if name, named := r.named[id]; named {
+		return name, nil
+	}
+	nsHandle := r.nsByLabel[msLabel].nsHandle
+	name := netNsNamePrefix + strconv.Itoa(id)
+	nsPath := filepath.Join(netNsRunDir, name)
+	if mounted, err := netns.GetFromPath(nsPath); err == nil {
+		sameNs := mounted.Equal(nsHandle)
+		_ = mounted.Close()
+		if sameNs {
+			// already mounted (e.g. before restart)
+			r.named[id] = name
+			return name, nil
+		}
+		// left behind by a previous run, the ID is now used for a different namespace
+		if err = unix.Unmount(nsPath, unix.MNT_DETACH); err != nil {
+			return "", fmt.Errorf("failed to unmount stale network namespace %s: %w", nsPath, err)
+		}
+	}
+	if err := os.MkdirAll(netNsRunDir, 0755); err != nil {
+		return "", err
+	}
+	mountPoint, err := os.OpenFile(nsPath, os.O_RDONLY|os.O_CREATE, 0444)
+	if err != nil {
+		return "", err
+	}
+	_ = mountPoint.Close()
+	nsFile := fmt.Sprintf("/proc/self/fd/%d", int(nsHandle))
+	if err = unix.Mount(nsFile, nsPath, "none", unix.MS_BIND, ""); err != nil {
+		return "", fmt.Errorf("failed to bind-mount network namespace of microservice %s to %s: %w",
+			msLabel, nsPath, err)
+	}
+	r.named[id] = name
+	return name, nil
+}
+
+// ReleaseNetNsName unmounts the name given by GetNetNsName to the network namespace referenced
+// by a given microservice label (if there is any), e.g. once the namespace is no longer used by linux-cp.
+func (r *netNsRegistry) ReleaseNetNsName(msLabel string) error {
+	ns, known := r.nsByLabel[msLabel]
+	if !known {
+		return nil
+	}
+	return r.unmountNetNs(ns.id)
+}
+
+// Close unmounts names of all network namespaces given by GetNetNsName.
+func (r *netNsRegistry) Close() (err error) {
+	for id := range r.named {
+		if unmountErr := r.unmountNetNs(id); unmountErr != nil && err == nil {
+			err = unmountErr
+		}
+	}
+	return err
+}
+
+// unmountNetNs removes the network namespace with the given ID from netNsRunDir (as "ip netns del" does).
+func (r *netNsRegistry) unmountNetNs(id int) error {
+	name, named := r.named[id]
+	if !named {
+		return nil
+	}
+	nsPath := filepath.Join(netNsRunDir, name)
+	// EINVAL = not mounted anymore
+	if err := unix.Unmount(nsPath, unix.MNT_DETACH); err != nil &&
+		!errors.Is(err, unix.EINVAL) && !errors.Is(err, unix.ENOENT) {
+		return fmt.Errorf("failed to unmount network namespace %s: %w", nsPath, err)
+	}
+	delete(r.named, id)
+	if err := os.Remove(nsPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove mount point of network namespace %s: %w", nsPath, err)
+	}
+	return nil
+}
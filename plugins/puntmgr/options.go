@@ -28,6 +28,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
 
 	"go.pantheon.tech/stonework/plugins/cnfreg"
+	ndproxyplugin "go.pantheon.tech/stonework/plugins/ndproxy"
 )
 
 const (
@@ -48,6 +49,7 @@ func NewPlugin(opts ...Option) *Plugin {
 	p.CnfRegistry = &cnfreg.DefaultPlugin
 	p.IfPlugin = &ifplugin.DefaultPlugin
 	p.NsPlugin = &nsplugin.DefaultPlugin
+	p.NDProxy = &ndproxyplugin.DefaultPlugin
 	p.CfgClient = client.LocalClient
 	p.KVScheduler = &kvscheduler.DefaultPlugin
 
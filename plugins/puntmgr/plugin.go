@@ -21,7 +21,9 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 	"sync"
+	"time"
 
 	"go.ligato.io/cn-infra/v2/datasync/kvdbsync/local"
 	"go.ligato.io/cn-infra/v2/infra"
@@ -37,11 +39,18 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
 	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 
+	"go.pantheon.tech/stonework/pkg/conc"
 	cnfreg_plugin "go.pantheon.tech/stonework/plugins/cnfreg"
+	ndproxyplugin "go.pantheon.tech/stonework/plugins/ndproxy"
 	"go.pantheon.tech/stonework/proto/cnfreg"
 	pb "go.pantheon.tech/stonework/proto/puntmgr"
 )
 
+// ErrPuntPending is returned by AddPunt with SyncConfig enabled for punt which is not yet configured.
+// The error is meant to be retried by KVScheduler (see Config.SyncConfig) and it wraps the failure
+// of the previous attempt to configure the punt, if there was any.
+var ErrPuntPending = errors.New("punt configuration is in progress")
+
 // These constants specify label for Internal StoneWork configuration (that is configuration
 // not configured by the user or SW-Modules).
 const InternalConfigLabelKey = "io.ligato.from-client"
@@ -51,8 +60,8 @@ const InternalConfigLabelValue = "stonework"
 // between VPP and the same or distinct Linux network namespace(s). Unless there is a conflict between punt requests,
 // the manager will ensure that common configuration items are shared and properly updated (e.g. ABX rules, TAP
 // connection, etc.). The manager supports different kinds of packet punting approaches for L2 or L3 source VPP
-// interfaces, with memifs, TAPs or AF-UNIX sockets used to deliver packets to the Linux network stack / user-space
-// application.
+// interfaces, with memifs, TAPs, veths (AF_PACKET/AF_XDP) or AF-UNIX sockets used to deliver packets to the Linux
+// network stack / user-space application.
 // The plugin can be used by:
 //   - STANDALONE CNF (even for a single punt it is a good practise to use the plugin),
 //   - StoneWork to orchestrate punt between the all-in-one VPP and every SW-Module,
@@ -66,8 +75,23 @@ type Plugin struct {
 
 	notifDescr   *puntNotifDescriptor
 	puntHandlers map[pb.PuntRequest_PuntType]PuntHandler
+	netNsReg     NetNsRegistry
 	icManager    InterconnectManager
 	punts        map[puntID]*punt
+	restored     map[puntID]*punt // loaded from checkpoint and not yet re-added
+	reconciled   bool             // restored state was reconciled with VPP
+	watchers     map[*puntWatcher]struct{}
+
+	// checkpoint writer (nil channels if checkpointing is disabled)
+	cpDirty      chan struct{}
+	cpStop       chan struct{}
+	cpWriterDone chan struct{}
+
+	// Plugin mutex protects only the internal state and it is never held across remote calls.
+	// Remote calls are instead serialized per CNF (key = CNF microservice label), so that
+	// a slow SW-Module does not delay punt processing for other CNFs.
+	cnfTxnQueues      conc.KeyedQueue[string] // configuration transactions of punts
+	cnfAnnounceQueues conc.KeyedQueue[string] // announcements of punt state changes (SW-Modules only)
 }
 
 // Deps is a set of dependencies of the Punt Manager plugin
@@ -78,6 +102,7 @@ type Deps struct {
 	CnfRegistry  cnfreg_plugin.CnfRegistryAPI
 	IfPlugin     ifplugin.API
 	NsPlugin     nsplugin.API
+	NDProxy      ndproxyplugin.API
 	CfgClient    client.GenericClient
 	KVScheduler  kvs.KVScheduler
 }
@@ -98,6 +123,13 @@ type PuntManagerAPI interface {
 	DelPunt(cnfMsLabel, key string, label string) error
 	// GetPuntDependencies returns dependencies that have to be satisfied before the punt can be added.
 	GetPuntDependencies(cnfMsLabel string, punt *pb.PuntRequest) (deps []kvs.Dependency)
+	// GetInterconnectSubnetUsage returns usage of CIDR pools from which subnets are allocated for interconnects.
+	GetInterconnectSubnetUsage() []*pb.SubnetPoolUsage
+	// ResyncCnf re-sends metadata of all punts and the CNF side of their interconnects to the (restarted) CNF.
+	// Only available in StoneWork.
+	ResyncCnf(cnfMsLabel string) error
+	// GetSupportedPunts returns all punt types and interconnect types supported by this Punt Manager.
+	GetSupportedPunts() (puntTypes []pb.PuntRequest_PuntType, icTypes []pb.PuntRequest_InterconnectType)
 }
 
 // API to obtain names of configuration items generated for punts.
@@ -111,7 +143,9 @@ type PuntManagerNamingAPI interface {
 
 // InterconnectLink is one of the:
 //   - AF-UNIX socket
-//   - pair of interfaces (memif or TAP)
+//   - pair of interfaces (memif, TAP or veth attached to VPP as AF_PACKET/AF_XDP)
+//   - tunnel to a remote destination (GRE or ERSPAN)
+//   - linux-cp interface pair
 //
 // and each type has type-specific parameters.
 type InterconnectLink interface {
@@ -134,7 +168,7 @@ func (l *AFUnixLink) equivalent(link InterconnectLink) bool {
 	return l.socketPath == l2.socketPath
 }
 
-// Interface-based interconnect (either with memif or TAP).
+// Interface-based interconnect (memif, TAP or veth attached to VPP as AF_PACKET/AF_XDP).
 type InterfaceLink struct {
 	// Name of the interface on the VPP side of the interconnect.
 	// If empty then the interface name will be generated by PuntManager.
@@ -179,6 +213,49 @@ func (l *InterfaceLink) equivalent(link InterconnectLink) bool {
 		isSubsetOf(l2.ipAddresses, l.ipAddresses)
 }
 
+// Tunnel from VPP to a remote destination (there is no CNF side of the interconnect).
+type TunnelLink struct {
+	tunnelType pb.PuntRequest_RemoteSpan_TunnelType
+	srcAddress string
+	dstAddress string
+	outerVrf   uint32
+	sessionID  uint32
+}
+
+func (*TunnelLink) isInterconnectLink() {}
+
+func (l *TunnelLink) equivalent(link InterconnectLink) bool {
+	l2, isTunnelLink := link.(*TunnelLink)
+	if !isTunnelLink {
+		return false
+	}
+	return *l == *l2
+}
+
+// Linux-CP interface pair mirroring VPP interface into the network namespace of a CNF
+// (the VPP side of the interconnect is the mirrored interface itself).
+type LinuxCPLink struct {
+	// VPP interface to mirror.
+	vppInterface string
+	// Name of the host interface created in the CNF.
+	// If empty then the interface name will be generated by PuntManager.
+	hostIfName string
+	// ID of VRF table that the VPP interface is assigned to.
+	vrf uint32
+	// If enabled the VRF on the CNF/Linux side will not be created.
+	withoutCNFVrf bool
+}
+
+func (*LinuxCPLink) isInterconnectLink() {}
+
+func (l *LinuxCPLink) equivalent(link InterconnectLink) bool {
+	l2, isLinuxCPLink := link.(*LinuxCPLink)
+	if !isLinuxCPLink {
+		return false
+	}
+	return *l == *l2
+}
+
 // Request to build a VPP<->CNF interconnect.
 type InterconnectReq struct {
 	link InterconnectLink
@@ -199,9 +276,9 @@ type PuntHandler interface {
 
 	// CanMultiplex enables interconnection multiplexing for this punting. It could be enabled in certain cases:
 	// 1. two or more punts of this type can coexist even if they have the same vpp selector
-	// 2. one or more punts of this type can coexist with other type of punts on the same (TAP-only)
+	// 2. one or more punts of this type can coexist with other type of punts on the same (TAP, AF_PACKET or AF_XDP)
 	// interconnection if they all have the same vpp selector and cnf selector.
-	// The TAP-backed interconnection is shared for multiple multiplexing punts with the same cnf selector
+	// The TAP/veth-backed interconnection is shared for multiple multiplexing punts with the same cnf selector
 	// (same network namespace) and vpp selector.
 	CanMultiplex() bool
 
@@ -224,14 +301,22 @@ func (id puntID) String() string {
 // punt groups request with metadata (=response).
 type punt struct {
 	state    pb.PuntState
+	err      error // set in the FAILED state
+	prevErr  error // failure of the previous attempt (punt re-created from the FAILED state)
 	request  *pb.PuntRequest
 	metadata *pb.PuntMetadata
+	// closed once the CREATED state is announced to the SW-Module (nil until then)
+	announced <-chan struct{}
 }
 
 // Init initializes internal attributes and in the case of STONEWORK_MODULE also starts gRPC server
 // for RegisterCreatedPunt and UnregisterDeletedPunt methods.
 func (p *Plugin) Init() (err error) {
 	p.punts = make(map[puntID]*punt)
+	p.restored = make(map[puntID]*punt)
+	p.watchers = make(map[*puntWatcher]struct{})
+	p.cnfTxnQueues = conc.NewKeyedQueue[string]()
+	p.cnfAnnounceQueues = conc.NewKeyedQueue[string]()
 	p.puntHandlers = make(map[pb.PuntRequest_PuntType]PuntHandler)
 
 	p.config, err = p.loadConfig()
@@ -240,13 +325,21 @@ func (p *Plugin) Init() (err error) {
 	}
 
 	cnfMode := p.CnfRegistry.GetCnfMode()
-	if cnfMode == cnfreg.CnfMode_STONEWORK_MODULE {
-		// serve UpdatePuntState
-		grpcServer := p.GRPCServer.GetServer()
-		if grpcServer == nil {
-			return errors.New("gRPC server is not initialized")
-		}
+	if p.config.SyncConfig && cnfMode != cnfreg.CnfMode_STONEWORK {
+		// only proxy descriptors of StoneWork handle ErrPuntPending (as a retriable failure)
+		return fmt.Errorf("\"sync-config\" is not supported in the CNF mode %v", cnfMode)
+	}
+	if p.config.SyncConfig && !p.CnfRegistry.NBTxnsWithRetry() {
+		// pending proxied items complete only through the retry
+		return errors.New("\"sync-config\" requires NB transactions committed with retry " +
+			"(orchestrator has to use KVScheduler returned by cnfreg NBTxnScheduler)")
+	}
+	grpcServer := p.GRPCServer.GetServer()
+	if grpcServer != nil {
+		// serve UpdatePuntState (SW-Module) and the read/watch API
 		pb.RegisterPuntManagerServer(grpcServer, p)
+	} else if cnfMode == cnfreg.CnfMode_STONEWORK_MODULE {
+		return errors.New("gRPC server is not initialized")
 	}
 
 	// register descriptor for punt notifications
@@ -258,27 +351,85 @@ func (p *Plugin) Init() (err error) {
 	}
 
 	// register punt handlers
+	p.netNsReg = NewNetNsRegistry(p.NsPlugin, p.ServiceLabel)
 	p.puntHandlers[pb.PuntRequest_HAIRPIN_XCONNECT] = NewHairpinXConnPuntHandler()
 	p.puntHandlers[pb.PuntRequest_HAIRPIN] = NewHairpinPuntHandler()
-	p.puntHandlers[pb.PuntRequest_SPAN] = NewSpanPuntHandler()
+	spanHandler := NewSpanPuntHandler()
+	p.puntHandlers[pb.PuntRequest_SPAN] = spanHandler
+	p.puntHandlers[pb.PuntRequest_REMOTE_SPAN] = spanHandler
 	p.puntHandlers[pb.PuntRequest_ABX] = NewAbxPuntHandler(p.IfPlugin)
 	p.puntHandlers[pb.PuntRequest_PUNT_TO_SOCKET] = NewSocketPuntHandler()
 	p.puntHandlers[pb.PuntRequest_DHCP_PROXY] = NewDhcpProxyPuntHandler()
 	p.puntHandlers[pb.PuntRequest_ISISX] = NewIsisxPuntHandler()
+	p.puntHandlers[pb.PuntRequest_IP_REDIRECT] = NewIPRedirectPuntHandler()
+	p.puntHandlers[pb.PuntRequest_LINUX_CP] = NewLinuxCPPuntHandler(p.netNsReg)
 
 	// prepare interconnect manager
-	_, allocCidr, err := net.ParseCIDR(p.config.InterconnectAllocCIDR)
+	var allocCidrs []*net.IPNet
+	if p.config.InterconnectAllocCIDR != "" {
+		_, allocCidr, err := net.ParseCIDR(p.config.InterconnectAllocCIDR)
+		if err != nil {
+			return fmt.Errorf("failed to parse \"interconnect-alloc-cidr\": %w", err)
+		}
+		allocCidrs = append(allocCidrs, allocCidr)
+	}
+	for _, cidr := range p.config.InterconnectAllocCIDRs {
+		_, allocCidr, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("failed to parse \"interconnect-alloc-cidrs\": %w", err)
+		}
+		allocCidrs = append(allocCidrs, allocCidr)
+	}
+	var allocCidrV6 *net.IPNet
+	if p.config.InterconnectAllocCIDRv6 != "" {
+		_, allocCidrV6, err = net.ParseCIDR(p.config.InterconnectAllocCIDRv6)
+		if err != nil {
+			return fmt.Errorf("failed to parse \"interconnect-alloc-cidr-v6\": %w", err)
+		}
+		if prefixLen := p.config.InterconnectAllocPrefixLenV6; prefixLen != 126 && prefixLen != 127 {
+			return fmt.Errorf("invalid \"interconnect-alloc-prefix-len-v6\": %d (expected 126 or 127)", prefixLen)
+		}
+	}
+	withNDProxy := p.NDProxy != nil && p.NDProxy.IsNDProxySupported()
+	if !withNDProxy {
+		p.Log.Warn("IPv6 ND proxy is not supported, punts proxying IPv6 networks of VPP interfaces will fail")
+	}
+	p.icManager, err = NewInterconnectManager(p.Log.NewLogger("icManager"), p.IfPlugin, p.ServiceLabel,
+		p.netNsReg, allocCidrs, allocCidrV6, p.config.InterconnectAllocPrefixLenV6, withNDProxy)
 	if err != nil {
-		return fmt.Errorf("failed to parse \"interconnect-alloc-cidr\": %w", err)
+		return fmt.Errorf("failed to create interconnect manager: %w", err)
+	}
+
+	// restore state checkpointed before restart
+	// (SW-Module learns the state of punts from StoneWork)
+	if cnfMode != cnfreg.CnfMode_STONEWORK_MODULE && p.config.StateFile != "" {
+		if err = p.loadCheckpoint(); err != nil {
+			// do not block the agent from starting, punts will be rebuilt from scratch
+			p.Log.Warn(err)
+			err = nil
+		}
+		if len(p.restored) > 0 {
+			time.AfterFunc(p.config.RestoreTimeout, p.dropRestoredPunts)
+			err = p.KVScheduler.RegisterKVDescriptor(p.newCheckpointDescriptor())
+			if err != nil {
+				return err
+			}
+		}
+		p.startCheckpointWriter()
 	}
-	p.icManager = NewInterconnectManager(p.Log.NewLogger("icManager"), p.IfPlugin, p.ServiceLabel,
-		p.NsPlugin, allocCidr)
 	return nil
 }
 
-// Close is NOOP.
+// Close writes pending changes of the state into the checkpoint and unmounts names given
+// to network namespaces of CNFs.
 func (p *Plugin) Close() error {
-	return nil
+	p.stopCheckpointWriter()
+	if p.netNsReg == nil {
+		return nil
+	}
+	p.Lock()
+	defer p.Unlock()
+	return p.netNsReg.Close()
 }
 
 // GetPuntMetadata returns metadata about configured packet punting between VPP and the CNF.
@@ -321,10 +472,12 @@ func (p *Plugin) GetAllCNFPunts(cnfMsLabel string) (punts []*pb.PuntMetadata) {
 
 // AddPunt is used by StoneWork or standalone CNF to configure punt between VPP and the CNF.
 // If cnfMsLabel is empty then microservice label of this CNF is assumed (returned by ServiceLabel plugin).
+// Punt is configured asynchronously. Adding already existing punt with the same request does nothing,
+// unless the punt has FAILED, in which case the punt is re-created.
+// With SyncConfig enabled, ErrPuntPending is returned until the punt is CREATED and the configuration
+// error is returned for a FAILED punt (re-created in the background to be checked by the next call).
+// AddPunt never waits for the configuration - the caller is expected to be retried by KVScheduler.
 func (p *Plugin) AddPunt(cnfMsLabel, key string, puntReq *pb.PuntRequest) error {
-	p.Lock()
-	defer p.Unlock()
-
 	cnfMode := p.CnfRegistry.GetCnfMode()
 	if cnfMode == cnfreg.CnfMode_STONEWORK_MODULE {
 		panic(fmt.Errorf("method AddPunt is not available in the CNF mode %v", cnfMode))
@@ -332,8 +485,6 @@ func (p *Plugin) AddPunt(cnfMsLabel, key string, puntReq *pb.PuntRequest) error
 	if cnfMode == cnfreg.CnfMode_STANDALONE && puntReq.InterconnectType == pb.PuntRequest_MEMIF {
 		return errors.New("it is not supported to punt with memif within a standalone CNF")
 	}
-
-	// check for duplicity
 	if cnfMsLabel == "" {
 		cnfMsLabel = p.ServiceLabel.GetAgentLabel()
 	}
@@ -342,37 +493,102 @@ func (p *Plugin) AddPunt(cnfMsLabel, key string, puntReq *pb.PuntRequest) error
 		key:        key,
 		label:      puntReq.GetLabel(),
 	}
-	_, exists := p.punts[id]
-	if exists {
-		return fmt.Errorf("punt already exists: %v", id)
-	}
 
 	// prepare gRPC clients
-	var (
-		err             error
-		remoteCfgClient client.GenericClient
-		remoteCnfClient pb.PuntManagerClient
-		remoteTxn       client.ChangeRequest
-	)
-	if cnfMode == cnfreg.CnfMode_STONEWORK {
-		remoteCfgClient, err = p.CnfRegistry.GetCnfCfgClient(cnfMsLabel)
-		if err != nil {
-			p.Log.Error(err)
-			return err
+	remoteCfgClient, remoteCnfClient, err := p.getRemoteClients(cnfMode, cnfMsLabel)
+	if err != nil {
+		p.Log.Error(err)
+		return err
+	}
+
+	// update the internal state and prepare transactions
+	cleanupOp, addOp, failure, err := p.preparePuntAdd(cnfMode, id, puntReq, remoteCfgClient)
+	if err != nil || addOp == nil {
+		return err
+	}
+	if cleanupOp != nil {
+		// remove leftovers of the failed punt first
+		p.announcePuntState(remoteCnfClient, cleanupOp.id, cleanupOp.punt)
+		p.cnfTxnQueues.Submit(cnfMsLabel, func() { p.applyPuntOp(cleanupOp) })
+	}
+
+	// send announcement about created packet punting into CNF
+	// (announcements are not waited for, with SyncConfig the punt is reported as pending until
+	// the SW-Module has learned its metadata)
+	p.announcePuntState(remoteCnfClient, id, addOp.punt)
+
+	// configure punt asynchronously, serialized with other punt operations for the same CNF
+	// (the caller is typically a KV descriptor and the transactions cannot be committed
+	// until the transaction of the caller is finalized)
+	addOp.remoteCnfClient = remoteCnfClient
+	p.cnfTxnQueues.Submit(cnfMsLabel, func() { p.applyPuntOp(addOp) })
+
+	if p.config.SyncConfig {
+		if failure != nil {
+			return failure
 		}
-		remoteTxn = remoteCfgClient.ChangeRequest()
-		cnfConn, err := p.CnfRegistry.GetCnfGrpcConn(cnfMsLabel)
-		if err != nil {
-			p.Log.Error(err)
-			return err
+		return puntPendingError(id, "waiting for configuration", addOp.punt.prevErr)
+	}
+	return nil
+}
+
+// puntPendingError returns ErrPuntPending for the given punt, wrapping the failure of the previous attempt
+// (if any) so that the caller learns why the punt is not configured yet.
+func puntPendingError(id puntID, reason string, prevErr error) error {
+	if prevErr != nil {
+		return fmt.Errorf("%w: %v (%s, previous attempt failed: %w)", ErrPuntPending, id, reason, prevErr)
+	}
+	return fmt.Errorf("%w: %v (%s)", ErrPuntPending, id, reason)
+}
+
+// preparePuntAdd checks for duplicity, creates interconnects and prepares operation to configure the punt.
+// For FAILED punt also an operation to clean up the previous attempt is returned, together with the failure.
+// Nil addOp with nil error means that there is nothing to do.
+func (p *Plugin) preparePuntAdd(cnfMode cnfreg.CnfMode, id puntID, puntReq *pb.PuntRequest,
+	remoteCfgClient client.GenericClient) (cleanupOp, addOp *puntOp, failure, err error) {
+	p.Lock()
+	defer p.Unlock()
+
+	// check for duplicity
+	if existing, exists := p.punts[id]; exists {
+		if !proto.Equal(existing.request, puntReq) {
+			return nil, nil, nil, fmt.Errorf("punt already exists: %v", id)
+		}
+		switch existing.state {
+		case pb.PuntState_INIT:
+			if p.config.SyncConfig {
+				return nil, nil, nil, puntPendingError(id, "waiting for configuration", existing.prevErr)
+			}
+			return nil, nil, nil, nil
+		case pb.PuntState_CREATED:
+			if p.config.SyncConfig && !isClosed(existing.announced) {
+				// SW-Module should learn the punt metadata before it receives configuration that may need them
+				return nil, nil, nil, puntPendingError(id, "waiting for SW-Module to learn metadata", existing.prevErr)
+			}
+			return nil, nil, nil, nil
+		case pb.PuntState_FAILED:
+			// re-create the punt from scratch
+			p.Log.Infof("Re-creating failed punt %v", id)
+			failure = fmt.Errorf("punt %v failed: %w", id, existing.err)
+			cleanupOp, err = p.preparePuntDel(cnfMode, id, existing, remoteCfgClient)
+			if err != nil {
+				p.Log.Error(err)
+				return nil, nil, nil, err
+			}
+		default:
+			return nil, nil, nil, nil
 		}
-		remoteCnfClient = pb.NewPuntManagerClient(cnfConn)
+	}
+
+	var remoteTxn client.ChangeRequest
+	if remoteCfgClient != nil {
+		remoteTxn = remoteCfgClient.ChangeRequest()
 	}
 
 	// obtain interconnect requirements from the punt handler
 	puntHandler, hasHandler := p.puntHandlers[puntReq.GetPuntType()]
 	if !hasHandler {
-		return fmt.Errorf("punt type %v is not supported", puntReq.GetPuntType())
+		return nil, nil, nil, fmt.Errorf("punt type %v is not supported", puntReq.GetPuntType())
 	}
 	withMultiplex := puntHandler.CanMultiplex()
 	icReqs := puntHandler.GetInterconnectReqs(puntReq)
@@ -384,7 +600,7 @@ func (p *Plugin) AddPunt(cnfMsLabel, key string, puntReq *pb.PuntRequest) error
 	interconnects, err := p.icManager.AddInterconnects(localTxn, remoteTxn, id, icReqs, icType, enableGso, withMultiplex)
 	if err != nil {
 		p.Log.Error(err)
-		return err
+		return nil, nil, nil, err
 	}
 
 	// try to configure punt
@@ -393,7 +609,7 @@ func (p *Plugin) AddPunt(cnfMsLabel, key string, puntReq *pb.PuntRequest) error
 		p.Log.Error(err)
 		// cleanup (of the IC manager internal state)
 		_ = p.icManager.DelInterconnects(localTxn, remoteTxn, id)
-		return err
+		return nil, nil, nil, err
 	}
 
 	// store punt metadata
@@ -405,81 +621,34 @@ func (p *Plugin) AddPunt(cnfMsLabel, key string, puntReq *pb.PuntRequest) error
 		},
 		Interconnects: interconnects,
 	}
-	puntState := pb.PuntState_INIT
-	p.punts[id] = &punt{
-		state:    puntState,
+	added := &punt{
+		state:    pb.PuntState_INIT,
 		request:  puntReq,
 		metadata: puntMeta,
 	}
-
-	// send announcement about created packet punting into CNF
-	if cnfMode == cnfreg.CnfMode_STONEWORK {
-		_, err = remoteCnfClient.UpdatePuntState(context.Background(),
-			&pb.UpdatePuntStateReq{
-				Metadata: puntMeta,
-				State:    puntState,
-			})
-		if err != nil {
-			// ignore any errors at this point
-			p.Log.Error(err)
-			err = nil
-		}
+	if failure != nil {
+		added.prevErr = errors.Unwrap(failure)
 	}
-
-	// configure punt asynchronously
-	go func() {
-		// ignore errors - they may get fixed by retrying
-		if err = localTxn.Send(context.Background()); err != nil {
-			p.Log.Error(err)
-		}
-		if cnfMode == cnfreg.CnfMode_STONEWORK {
-			if err = remoteTxn.Send(context.Background()); err != nil {
-				p.Log.Error(err)
-			}
-		}
-
-		puntState = pb.PuntState_CREATED
-		if cnfMode == cnfreg.CnfMode_STONEWORK {
-			_, err = remoteCnfClient.UpdatePuntState(context.Background(),
-				&pb.UpdatePuntStateReq{
-					Metadata: puntMeta,
-					State:    puntState,
-				})
-			if err != nil {
-				// ignore any errors at this point
-				p.Log.Error(err)
-				err = nil
-			}
-		}
-
-		// publish notification about newly configured punt
-		p.notifDescr.notify(id, false)
-
-		p.Lock()
-		defer p.Unlock()
-		punt, exists := p.punts[id]
-		if !exists {
-			// highly unlikely
-			p.Log.Warnf("punt removed before it was fully configured")
-			return
-		}
-		punt.state = puntState
-	}()
-	return nil
+	p.punts[id] = added
+	p.claimRestoredPunt(id, puntMeta)
+	p.scheduleCheckpoint()
+	p.publishPuntEvent(id, added, pb.PuntState_UNKNOWN)
+	addOp = &puntOp{
+		id:        id,
+		punt:      added,
+		localTxn:  localTxn,
+		remoteTxn: remoteTxn,
+	}
+	return cleanupOp, addOp, failure, nil
 }
 
 // DelPunt is used by StoneWork or standalone CNF to un-configure punt between VPP and the CNF.
 // If cnfMsLabel is empty then microservice label of this CNF is assumed (returned by ServiceLabel plugin).
 func (p *Plugin) DelPunt(cnfMsLabel, key, label string) error {
-	p.Lock()
-	defer p.Unlock()
-
 	cnfMode := p.CnfRegistry.GetCnfMode()
 	if cnfMode == cnfreg.CnfMode_STONEWORK_MODULE {
 		panic(fmt.Errorf("method DelPunt is not available in the CNF mode %v", cnfMode))
 	}
-
-	// check if the punt is created
 	if cnfMsLabel == "" {
 		cnfMsLabel = p.ServiceLabel.GetAgentLabel()
 	}
@@ -488,88 +657,313 @@ func (p *Plugin) DelPunt(cnfMsLabel, key, label string) error {
 		key:        key,
 		label:      label,
 	}
+
+	// prepare gRPC clients
+	// (punts of departed SW-Module are removed only on the side of VPP)
+	remoteCfgClient, remoteCnfClient, err := p.getRemoteClients(cnfMode, cnfMsLabel)
+	if errors.Is(err, cnfreg_plugin.ErrCnfNotLoaded) {
+		p.Log.Debugf("Removing punt of departed CNF %s", cnfMsLabel)
+	} else if err != nil {
+		p.Log.Error(err)
+		return err
+	}
+
+	// check if the punt is created
+	p.Lock()
 	punt, exists := p.punts[id]
 	if !exists {
+		p.Unlock()
 		return fmt.Errorf("unknown punt: %v", id)
 	}
+	delOp, err := p.preparePuntDel(cnfMode, id, punt, remoteCfgClient)
+	p.Unlock()
+	if err != nil {
+		p.Log.Error(err)
+		return err
+	}
+
+	// publish notification already before the punt is removed
+	go p.notifDescr.notify(id, true)
+
+	// send announcement about deleted packet punting into CNF
+	p.announcePuntState(remoteCnfClient, id, delOp.punt)
+
+	// un-configure punt asynchronously, serialized with other punt operations for the same CNF
+	p.cnfTxnQueues.Submit(cnfMsLabel, func() { p.applyPuntOp(delOp) })
+	return nil
+}
+
+// preparePuntDel prepares operation to un-configure the given punt and removes the punt from the internal state.
+// The method should be called with the plugin locked.
+func (p *Plugin) preparePuntDel(cnfMode cnfreg.CnfMode, id puntID, punt *punt,
+	remoteCfgClient client.GenericClient) (*puntOp, error) {
 	puntReq := punt.request
 	puntMeta := punt.metadata
 
-	// prepare gRPC clients
-	var (
-		err             error
-		remoteCfgClient client.GenericClient
-		remoteCnfClient pb.PuntManagerClient
-		remoteTxn       client.ChangeRequest
-	)
-	if cnfMode == cnfreg.CnfMode_STONEWORK {
-		remoteCfgClient, err = p.CnfRegistry.GetCnfCfgClient(cnfMsLabel)
-		if err != nil {
-			p.Log.Error(err)
-			return err
-		}
+	var remoteTxn, discardedTxn client.ChangeRequest
+	if remoteCfgClient != nil {
 		remoteTxn = remoteCfgClient.ChangeRequest()
-		cnfConn, err := p.CnfRegistry.GetCnfGrpcConn(cnfMsLabel)
-		if err != nil {
-			p.Log.Error(err)
-			return err
-		}
-		remoteCnfClient = pb.NewPuntManagerClient(cnfConn)
+	} else if cnfMode == cnfreg.CnfMode_STONEWORK {
+		// CNF has departed, its side of interconnects has gone with it
+		discardedTxn = newPuntChangeRequest(nil)
 	}
 
 	// try to remove punt
 	puntHandler, hasHandler := p.puntHandlers[puntReq.GetPuntType()]
 	if !hasHandler {
-		return fmt.Errorf("punt type %v is not supported", puntReq.GetPuntType())
+		return nil, fmt.Errorf("punt type %v is not supported", puntReq.GetPuntType())
 	}
 	localTxn := p.CfgClient.ChangeRequest()
-	err = puntHandler.ConfigurePunt(localTxn, id, puntReq, puntMeta.Interconnects, true)
+	err := puntHandler.ConfigurePunt(localTxn, id, puntReq, puntMeta.Interconnects, true)
 	if err != nil {
-		p.Log.Error(err)
-		return err
+		return nil, err
 	}
 
 	// try to remove interconnects
-	err = p.icManager.DelInterconnects(localTxn, remoteTxn, id)
+	if discardedTxn != nil {
+		err = p.icManager.DelInterconnects(localTxn, discardedTxn, id)
+	} else {
+		err = p.icManager.DelInterconnects(localTxn, remoteTxn, id)
+	}
 	if err != nil {
-		p.Log.Error(err)
-		return err
+		return nil, err
 	}
 
 	// remove metadata from memory
 	delete(p.punts, id)
-	puntState := pb.PuntState_DELETED
+	p.scheduleCheckpoint()
+	deleted := *punt
+	deleted.state = pb.PuntState_DELETED
+	p.publishPuntEvent(id, &deleted, punt.state)
+	return &puntOp{
+		id:        id,
+		punt:      &deleted,
+		remove:    true,
+		localTxn:  localTxn,
+		remoteTxn: remoteTxn,
+	}, nil
+}
 
-	// send announcement about deleted packet punting into CNF
-	if cnfMode == cnfreg.CnfMode_STONEWORK {
-		_, err = remoteCnfClient.UpdatePuntState(context.Background(),
-			&pb.UpdatePuntStateReq{
-				Metadata: puntMeta,
-				State:    puntState,
-			})
+// puntOp is a prepared (un)configuration of a punt, applied asynchronously from the queue of the CNF.
+type puntOp struct {
+	id              puntID
+	punt            *punt
+	remove          bool
+	localTxn        client.ChangeRequest
+	remoteTxn       client.ChangeRequest // nil outside StoneWork
+	remoteCnfClient pb.PuntManagerClient // nil outside StoneWork
+}
+
+// applyPuntOp commits transactions of the punt operation. For added punt the resulting state
+// is recorded, announced and notified.
+func (p *Plugin) applyPuntOp(op *puntOp) {
+	txnErr := p.sendPuntTxns(op.localTxn, op.remoteTxn, op.remove)
+	if op.remove {
+		if op.punt.request.GetPuntType() == pb.PuntRequest_LINUX_CP {
+			// linux-cp needs the name of the namespace until the pair is removed
+			p.releaseNetNsName(op.id.cnfMsLabel)
+		}
+		return
+	}
+
+	puntState := pb.PuntState_CREATED
+	if txnErr != nil {
+		p.Log.Errorf("failed to configure punt %v: %v", op.id, txnErr)
+		puntState = pb.PuntState_FAILED
+	}
+	p.Lock()
+	if p.punts[op.id] != op.punt {
+		// highly unlikely
+		p.Unlock()
+		p.Log.Warnf("punt removed before it was fully configured")
+		return
+	}
+	prevState := op.punt.state
+	op.punt.state = puntState
+	op.punt.err = txnErr
+	p.scheduleCheckpoint()
+	p.publishPuntEvent(op.id, op.punt, prevState)
+	p.Unlock()
+
+	announced := p.announcePuntState(op.remoteCnfClient, op.id, op.punt)
+	p.Lock()
+	op.punt.announced = announced
+	p.Unlock()
+
+	// publish notification about newly configured punt
+	if puntState == pb.PuntState_CREATED {
+		p.notifDescr.notify(op.id, false)
+	}
+}
+
+// releaseNetNsName unmounts the name of the network namespace of the CNF once it is not used
+// by any linux-cp punt (of this or another CNF sharing the namespace).
+func (p *Plugin) releaseNetNsName(cnfMsLabel string) {
+	p.Lock()
+	defer p.Unlock()
+	nsID, err := p.netNsReg.GetNetNsID(cnfMsLabel)
+	if err != nil || nsID == 0 {
+		return
+	}
+	for id, punt := range p.punts {
+		if punt.request.GetPuntType() != pb.PuntRequest_LINUX_CP {
+			continue
+		}
+		if usedBy, err := p.netNsReg.GetNetNsID(id.cnfMsLabel); err == nil && usedBy == nsID {
+			return
+		}
+	}
+	if err = p.netNsReg.ReleaseNetNsName(cnfMsLabel); err != nil {
+		p.Log.Warn(err)
+	}
+}
+
+// sendPuntTxns commits transactions (un)configuring a punt. VPP side is configured first and removed last.
+// Both transactions are always sent, the first error is returned.
+// Remote transaction is sent with the RPC deadline, local transaction with the local transaction deadline.
+func (p *Plugin) sendPuntTxns(localTxn, remoteTxn client.ChangeRequest, remove bool) (err error) {
+	sendLocal := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), p.config.LocalTxnTimeout)
+		defer cancel()
+		return localTxn.Send(ctx)
+	}
+	sendRemote := func() error {
+		if remoteTxn == nil {
+			return nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), p.config.RPCTimeout)
+		defer cancel()
+		return remoteTxn.Send(ctx)
+	}
+	sends := []func() error{sendLocal, sendRemote}
+	if remove {
+		sends = []func() error{sendRemote, sendLocal}
+	}
+	for _, send := range sends {
+		if txnErr := send(); txnErr != nil {
+			p.Log.Error(txnErr)
+			if err == nil {
+				err = txnErr
+			}
+		}
+	}
+	return err
+}
+
+// announcePuntState asynchronously sends the current state of the punt to the Punt Manager of the SW-Module.
+// Announcements for the same CNF are delivered in the order of submission. The returned channel is closed
+// once the announcement is sent (or failed). Without the client (outside StoneWork) nothing is sent.
+func (p *Plugin) announcePuntState(remoteCnfClient pb.PuntManagerClient, id puntID, punt *punt) <-chan struct{} {
+	if remoteCnfClient == nil {
+		return closedChan()
+	}
+	req := &pb.UpdatePuntStateReq{
+		Metadata: punt.metadata,
+		State:    punt.state,
+	}
+	if punt.err != nil {
+		req.Error = punt.err.Error()
+	}
+	return p.cnfAnnounceQueues.Submit(id.cnfMsLabel, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), p.config.RPCTimeout)
+		defer cancel()
+		_, err := remoteCnfClient.UpdatePuntState(ctx, req)
 		if err != nil {
 			// ignore any errors at this point
-			p.Log.Error(err)
-			err = nil
+			p.Log.Errorf("failed to announce state %v of punt %v: %v", req.State, id, err)
 		}
+	})
+}
+
+// closedChan returns channel which is already closed.
+func closedChan() <-chan struct{} {
+	done := make(chan struct{})
+	close(done)
+	return done
+}
+
+// isClosed returns true if the channel is closed (nil channel is never closed).
+func isClosed(ch <-chan struct{}) bool {
+	if ch == nil {
+		return false
+	}
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
 	}
+}
 
-	// publish notification already before the punt is removed
-	go p.notifDescr.notify(id, true)
+// getRemoteClients returns clients for the configuration and the Punt Manager of the given SW-Module.
+// Outside StoneWork nil clients are returned.
+func (p *Plugin) getRemoteClients(cnfMode cnfreg.CnfMode, cnfMsLabel string) (
+	remoteCfgClient client.GenericClient, remoteCnfClient pb.PuntManagerClient, err error) {
+	if cnfMode != cnfreg.CnfMode_STONEWORK {
+		return nil, nil, nil
+	}
+	remoteCfgClient, err = p.CnfRegistry.GetCnfCfgClient(cnfMsLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+	cnfConn, err := p.CnfRegistry.GetCnfGrpcConn(cnfMsLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+	return remoteCfgClient, pb.NewPuntManagerClient(cnfConn), nil
+}
 
-	// un-configure punt asynchronously
-	go func() {
-		// ignore errors - they may get fixed by retrying
-		if cnfMode == cnfreg.CnfMode_STONEWORK {
-			if err = remoteTxn.Send(context.Background()); err != nil {
-				p.Log.Error(err)
-			}
+// ResyncCnf re-sends metadata of all punts and the CNF side of their interconnects to the (restarted) CNF.
+// The method waits for the resync to complete.
+func (p *Plugin) ResyncCnf(cnfMsLabel string) error {
+	cnfMode := p.CnfRegistry.GetCnfMode()
+	if cnfMode != cnfreg.CnfMode_STONEWORK {
+		panic(fmt.Errorf("method ResyncCnf is not available in the CNF mode %v", cnfMode))
+	}
+	remoteCfgClient, remoteCnfClient, err := p.getRemoteClients(cnfMode, cnfMsLabel)
+	if err != nil {
+		return err
+	}
+
+	// snapshot the state of the CNF punts
+	p.Lock()
+	var (
+		ids   []puntID
+		punts []punt
+	)
+	for id, punt := range p.punts {
+		if id.cnfMsLabel == cnfMsLabel {
+			ids = append(ids, id)
+			punts = append(punts, *punt)
 		}
-		if err = localTxn.Send(context.Background()); err != nil {
-			p.Log.Error(err)
+	}
+	remoteTxn := remoteCfgClient.ChangeRequest()
+	p.icManager.ResyncRemote(remoteTxn, cnfMsLabel)
+	p.Unlock()
+	p.Log.Infof("Resyncing %d punts of CNF %s", len(ids), cnfMsLabel)
+
+	// re-announce punts, every punt has to go through INIT first
+	announced := closedChan()
+	for i, id := range ids {
+		initPunt := punts[i]
+		initPunt.state = pb.PuntState_INIT
+		initPunt.err = nil
+		announced = p.announcePuntState(remoteCnfClient, id, &initPunt)
+		if punts[i].state != pb.PuntState_INIT {
+			announced = p.announcePuntState(remoteCnfClient, id, &punts[i])
 		}
-	}()
-	return nil
+	}
+
+	// re-apply the CNF side of interconnects
+	var txnErr error
+	txnDone := p.cnfTxnQueues.Submit(cnfMsLabel, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), p.config.RPCTimeout)
+		defer cancel()
+		txnErr = remoteTxn.Send(ctx)
+	})
+	<-announced
+	<-txnDone
+	return txnErr
 }
 
 // GetPuntDependencies returns dependencies that have to be satisfied before the punt can be added.
@@ -586,6 +980,32 @@ func (p *Plugin) GetPuntDependencies(cnfMsLabel string, punt *pb.PuntRequest) (d
 	return
 }
 
+// GetInterconnectSubnetUsage returns usage of CIDR pools from which subnets are allocated for interconnects.
+func (p *Plugin) GetInterconnectSubnetUsage() []*pb.SubnetPoolUsage {
+	p.Lock()
+	defer p.Unlock()
+	return p.icManager.GetSubnetUsage()
+}
+
+// GetSupportedPunts returns all punt types and interconnect types supported by this Punt Manager.
+func (p *Plugin) GetSupportedPunts() (puntTypes []pb.PuntRequest_PuntType, icTypes []pb.PuntRequest_InterconnectType) {
+	for puntType := range p.puntHandlers {
+		puntTypes = append(puntTypes, puntType)
+	}
+	sort.Slice(puntTypes, func(i, j int) bool {
+		return puntTypes[i] < puntTypes[j]
+	})
+	icTypes = append(icTypes, SupportedInterconnectTypes...)
+	return puntTypes, icTypes
+}
+
+// GetSubnetUsage returns usage of CIDR pools from which subnets are allocated for interconnects.
+func (p *Plugin) GetSubnetUsage(_ context.Context, _ *pb.GetSubnetUsageReq) (*pb.GetSubnetUsageResp, error) {
+	return &pb.GetSubnetUsageResp{
+		Pools: p.GetInterconnectSubnetUsage(),
+	}, nil
+}
+
 // GetLinuxVrfName returns the name used for Linux VRF device corresponding to the given VPP VRF.
 // Method is "static" in the sense that it can be called anytime, regardless of the internal state of the plugin.
 func (p *Plugin) GetLinuxVrfName(vrf uint32) string {
@@ -593,20 +1013,38 @@ func (p *Plugin) GetLinuxVrfName(vrf uint32) string {
 }
 
 // UpdatePuntState is called by Punt Manager of StoneWork to notify SW-Module about state change of a punt.
-func (p *Plugin) UpdatePuntState(_ context.Context, req *pb.UpdatePuntStateReq) (resp *pb.UpdatePuntStateResp, err error) {
+func (p *Plugin) UpdatePuntState(ctx context.Context, req *pb.UpdatePuntStateReq) (resp *pb.UpdatePuntStateResp, err error) {
 	p.Log.Debugf("Handling UpdatePuntState (%+v)", req)
 	resp = &pb.UpdatePuntStateResp{}
+	if p.CnfRegistry.GetCnfMode() != cnfreg.CnfMode_STONEWORK_MODULE {
+		return resp, errors.New("punt state updates are only accepted by SW-Modules")
+	}
+	if err = p.CnfRegistry.VerifySWPeer(ctx); err != nil {
+		return resp, err
+	}
+	id := puntIdFromProto(req.Metadata)
+	// notifications are published with the plugin unlocked (may trigger KVScheduler transaction)
+	var notifyCreated, notifyRemoved bool
+	defer func() {
+		if notifyCreated || notifyRemoved {
+			p.notifDescr.notify(id, notifyRemoved)
+		}
+	}()
 	p.Lock()
 	defer p.Unlock()
 
-	id := puntIdFromProto(req.Metadata)
 	switch req.State {
 	case pb.PuntState_UNKNOWN:
 		p.Log.Warn("Ignoring unknown punt state")
 		return
 
 	case pb.PuntState_INIT:
-		_, exists := p.punts[id]
+		known, exists := p.punts[id]
+		if exists && proto.Equal(known.metadata, req.Metadata) {
+			// re-announced by restarted StoneWork with the restored metadata
+			p.Log.Debugf("Punt %s is already known with the same metadata", id.String())
+			return
+		}
 		if exists {
 			err = fmt.Errorf("punt %s is already known", id.String())
 			return resp, err
@@ -616,6 +1054,7 @@ func (p *Plugin) UpdatePuntState(_ context.Context, req *pb.UpdatePuntStateReq)
 			// request = nil
 			metadata: req.Metadata,
 		}
+		p.publishPuntEvent(id, p.punts[id], pb.PuntState_UNKNOWN)
 
 	case pb.PuntState_CREATED:
 		punt, exists := p.punts[id]
@@ -629,7 +1068,20 @@ func (p *Plugin) UpdatePuntState(_ context.Context, req *pb.UpdatePuntStateReq)
 			return
 		}
 		punt.state = req.State
-		p.notifDescr.notify(id, false)
+		notifyCreated = true
+		p.publishPuntEvent(id, punt, pb.PuntState_INIT)
+
+	case pb.PuntState_FAILED:
+		punt, exists := p.punts[id]
+		if !exists {
+			err = fmt.Errorf("missing INIT state update for punt %s", id.String())
+			return resp, err
+		}
+		notifyRemoved = punt.state == pb.PuntState_CREATED
+		prevState := punt.state
+		punt.state = req.State
+		punt.err = errors.New(req.Error)
+		p.publishPuntEvent(id, punt, prevState)
 
 	case pb.PuntState_DELETED:
 		punt, exists := p.punts[id]
@@ -638,10 +1090,11 @@ func (p *Plugin) UpdatePuntState(_ context.Context, req *pb.UpdatePuntStateReq)
 				id, req.State)
 			return
 		}
-		if punt.state == pb.PuntState_CREATED {
-			p.notifDescr.notify(id, true)
-		}
+		notifyRemoved = punt.state == pb.PuntState_CREATED
 		delete(p.punts, id)
+		deleted := *punt
+		deleted.state = req.State
+		p.publishPuntEvent(id, &deleted, punt.state)
 	}
 	return resp, nil
 }
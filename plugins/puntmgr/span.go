@@ -17,18 +17,34 @@
 package puntmgr
 
 import (
+	"fmt"
+	"net"
+
 	"go.ligato.io/vpp-agent/v3/client"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	vppacl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	vpp_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
 
 	pb "go.pantheon.tech/stonework/proto/puntmgr"
 )
 
-// spanPunt implements PuntHandler for PuntRequest_SPAN
-type spanPunt struct{}
+// spanPunt implements PuntHandler for PuntRequest_SPAN and PuntRequest_REMOTE_SPAN
+type spanPunt struct {
+	// SPAN Punt handler needs in-memory cache for the sake of multiplexing,
+	// key = VPP-side interface of the interconnect
+	spanPunts map[string][]spanPuntMeta
+}
+
+type spanPuntMeta struct {
+	puntId  puntID
+	puntReq *pb.PuntRequest
+}
 
 func NewSpanPuntHandler() PuntHandler {
-	return &spanPunt{}
+	return &spanPunt{
+		spanPunts: make(map[string][]spanPuntMeta),
+	}
 }
 
 // SpanInterfaceSelector is used only by spanPunt because SPAN can be combined with other punt types without conflicts.
@@ -36,14 +52,46 @@ func SpanInterfaceSelector(ifaceName string) string {
 	return "vpp/span/interface/" + ifaceName
 }
 
+// SpanL2InterfaceSelector is used by spanPunt for L2 SPAN. Device-level and L2 SPAN of the same interface
+// cannot share interconnect, SPAN is configured in VPP either at the device level or at the L2 level
+// for a given pair of interfaces.
+func SpanL2InterfaceSelector(ifaceName string) string {
+	return "vpp/span/l2/interface/" + ifaceName
+}
+
+// getSpan returns the traffic to copy for SPAN as well as for REMOTE_SPAN punt request.
+func getSpan(punt *pb.PuntRequest) *pb.PuntRequest_Span {
+	if remoteSpan := punt.GetRemoteSpan(); remoteSpan != nil {
+		return remoteSpan.GetSpan()
+	}
+	return punt.GetSpan()
+}
+
 // GetInterconnectReqs returns definitions of all interconnects which are required between VPP and CNF
 // for this punt request.
 func (p *spanPunt) GetInterconnectReqs(punt *pb.PuntRequest) []InterconnectReq {
-	if span := punt.GetSpan(); span != nil {
+	if span := getSpan(punt); span != nil {
+		vppSelector := SpanInterfaceSelector(span.VppInterface)
+		if span.IsL2 {
+			vppSelector = SpanL2InterfaceSelector(span.VppInterface)
+		}
+		var link InterconnectLink = &InterfaceLink{}
+		if remoteSpan := punt.GetRemoteSpan(); remoteSpan != nil {
+			tunnelLink := &TunnelLink{
+				tunnelType: remoteSpan.TunnelType,
+				srcAddress: remoteSpan.SrcAddress,
+				dstAddress: remoteSpan.DstAddress,
+				outerVrf:   remoteSpan.OuterVrf,
+			}
+			if remoteSpan.TunnelType == pb.PuntRequest_RemoteSpan_ERSPAN {
+				tunnelLink.sessionID = remoteSpan.SessionId
+			}
+			link = tunnelLink
+		}
 		return []InterconnectReq{
 			{
-				link:        &InterfaceLink{},
-				vppSelector: SpanInterfaceSelector(span.VppInterface),
+				link:        link,
+				vppSelector: vppSelector,
 			},
 		}
 	}
@@ -53,21 +101,33 @@ func (p *spanPunt) GetInterconnectReqs(punt *pb.PuntRequest) []InterconnectReq {
 // GetPuntDependencies returns dependencies that have to be satisfied before the punt can be added.
 func (p *spanPunt) GetPuntDependencies(punt *pb.PuntRequest) (deps []kvs.Dependency) {
 	// VPP interface
-	if span := punt.GetSpan(); span != nil {
+	if span := getSpan(punt); span != nil {
 		deps = append(deps,
 			kvs.Dependency{
 				Label: punt.GetLabel() + "-span-" + span.GetVppInterface(),
 				Key:   vpp_interfaces.InterfaceKey(span.GetVppInterface()),
 			})
 	}
+	// VRF used to route tunneled packets
+	if remoteSpan := punt.GetRemoteSpan(); remoteSpan.GetOuterVrf() != 0 {
+		protocol := vpp_l3.VrfTable_IPV4
+		if ip := net.ParseIP(remoteSpan.GetDstAddress()); ip != nil && ip.To4() == nil {
+			protocol = vpp_l3.VrfTable_IPV6
+		}
+		deps = append(deps,
+			kvs.Dependency{
+				Label: fmt.Sprintf("%s-span-vrf-%d", punt.GetLabel(), remoteSpan.GetOuterVrf()),
+				Key:   vpp_l3.VrfTableKey(remoteSpan.GetOuterVrf(), protocol),
+			})
+	}
 	return deps
 }
 
 // CanMultiplex enables interconnection multiplexing for this punting. It could be enabled in certain cases:
 // 1. two or more punts of this type can coexist even if they have the same vpp selector
-// 2. one or more punts of this type can coexist with other type of punts on the same (TAP-only)
+// 2. one or more punts of this type can coexist with other type of punts on the same (TAP, AF_PACKET or AF_XDP)
 // interconnection if they all have the same vpp selector and cnf selector.
-// The TAP-backed interconnection is shared for multiple multiplexing punts with the same cnf selector
+// The TAP/veth-backed interconnection is shared for multiple multiplexing punts with the same cnf selector
 // (same network namespace) and vpp selector.
 // SPAN key = input + output interface
 func (p *spanPunt) CanMultiplex() bool {
@@ -75,23 +135,209 @@ func (p *spanPunt) CanMultiplex() bool {
 }
 
 // ConfigurePunt prepares txn to (un)configures VPP-side of the punt.
+// Punts sharing the same interconnect are served by a single SPAN, which copies the union of the traffic
+// requested by these punts (i.e. combined direction and filters).
 func (p *spanPunt) ConfigurePunt(txn client.ChangeRequest, puntId puntID, puntReq *pb.PuntRequest,
 	interconnects []*pb.PuntMetadata_Interconnect, remove bool) error {
 
-	if interconnects[0].Shared {
-		// !remove: SPAN already configured
-		// remove: SPAN will be un-configured by the last punt request
-		return nil
+	spanReq := getSpan(puntReq)
+	vppInterface := spanReq.GetVppInterface()
+	icIface := interconnects[0].VppInterface.Name
+	if !remove {
+		if len(spanReq.GetFilters()) > 0 && !spanReq.GetIsL2() {
+			return fmt.Errorf("SPAN of interface %s: filters are supported only for L2 SPAN", vppInterface)
+		}
+		if _, err := p.buildFilterRules(spanReq.GetFilters()); err != nil {
+			return err
+		}
+		if remoteSpan := puntReq.GetRemoteSpan(); remoteSpan != nil {
+			if err := validateTunnel(remoteSpan); err != nil {
+				return err
+			}
+		}
 	}
-	span := &vpp_interfaces.Span{
-		InterfaceFrom: puntReq.GetSpan().VppInterface,
-		InterfaceTo:   interconnects[0].VppInterface.Name,
-		Direction:     vpp_interfaces.Span_BOTH,
+
+	// ACL filter configured before this change (if any)
+	prevAcl, err := p.buildFilterAcl(icIface)
+	if err != nil {
+		return err
 	}
+
+	// update the in-memory cache used for multiplexing
 	if remove {
-		txn.Delete(span)
+		var filtered []spanPuntMeta
+		for _, span := range p.spanPunts[icIface] {
+			if span.puntId != puntId {
+				filtered = append(filtered, span)
+			}
+		}
+		if len(filtered) == 0 {
+			delete(p.spanPunts, icIface)
+		} else {
+			p.spanPunts[icIface] = filtered
+		}
 	} else {
-		txn.Update(span)
+		p.spanPunts[icIface] = append(p.spanPunts[icIface],
+			spanPuntMeta{
+				puntId:  puntId,
+				puntReq: puntReq,
+			})
+	}
+
+	span := &vpp_interfaces.Span{
+		InterfaceFrom: vppInterface,
+		InterfaceTo:   icIface,
+		Direction:     p.getDirection(icIface),
+		IsL2:          spanReq.GetIsL2(),
+	}
+	acl, err := p.buildFilterAcl(icIface)
+	if err != nil {
+		// unreachable - filters of all punts were already validated
+		return err
+	}
+	if len(p.spanPunts[icIface]) == 0 {
+		// removed by the last punt request
+		txn.Delete(span)
+		if prevAcl != nil {
+			txn.Delete(prevAcl)
+		}
+		return nil
+	}
+	txn.Update(span)
+	if acl != nil {
+		txn.Update(acl)
+	} else if prevAcl != nil {
+		txn.Delete(prevAcl)
+	}
+	return nil
+}
+
+// getDirection returns direction of the SPAN covering all punts using the given interconnect.
+func (p *spanPunt) getDirection(icIface string) vpp_interfaces.Span_Direction {
+	var rx, tx bool
+	for _, span := range p.spanPunts[icIface] {
+		switch getSpan(span.puntReq).GetDirection() {
+		case pb.PuntRequest_Span_RX:
+			rx = true
+		case pb.PuntRequest_Span_TX:
+			tx = true
+		default:
+			rx, tx = true, true
+		}
+	}
+	switch {
+	case rx && !tx:
+		return vpp_interfaces.Span_RX
+	case tx && !rx:
+		return vpp_interfaces.Span_TX
+	}
+	return vpp_interfaces.Span_BOTH
+}
+
+// buildFilterAcl returns ACL applied in the egress direction of the interconnect to let through only
+// the copied traffic matching filters of punts using the interconnect. Returns nil if no filtering
+// should be done, i.e. if there is no punt using the interconnect or if any of them wants all the traffic.
+func (p *spanPunt) buildFilterAcl(icIface string) (*vppacl.ACL, error) {
+	if len(p.spanPunts[icIface]) == 0 {
+		return nil, nil
+	}
+	acl := &vppacl.ACL{
+		Name: "span/filter/" + icIface,
+		Interfaces: &vppacl.ACL_Interfaces{
+			Egress: []string{icIface},
+		},
+	}
+	for _, span := range p.spanPunts[icIface] {
+		filters := getSpan(span.puntReq).GetFilters()
+		if len(filters) == 0 {
+			return nil, nil
+		}
+		rules, err := p.buildFilterRules(filters)
+		if err != nil {
+			return nil, err
+		}
+		for _, ipRule := range rules {
+			acl.Rules = append(acl.Rules, &vppacl.ACL_Rule{
+				Action: vppacl.ACL_Rule_PERMIT,
+				IpRule: ipRule,
+			})
+		}
+	}
+	return acl, nil
+}
+
+// buildFilterRules translates the "any" address constant (and empty address) of SPAN filters to actual
+// IP networks. Rule with no real address is installed for both IPv4 and IPv6.
+func (p *spanPunt) buildFilterRules(in []*vppacl.ACL_Rule_IpRule) (out []*vppacl.ACL_Rule_IpRule, err error) {
+	for _, filter := range in {
+		if filter.Ip == nil {
+			out = append(out, filter)
+			continue
+		}
+		src := filter.Ip.SourceNetwork
+		dst := filter.Ip.DestinationNetwork
+		var forIPv4, forIPv6 bool
+		for _, addr := range []string{src, dst} {
+			if addr == "" || addr == anyAddrAlias {
+				continue
+			}
+			ip, _, err := net.ParseCIDR(addr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse IP network %s used with SPAN filter: %v", addr, err)
+			}
+			if ip.To4() != nil {
+				forIPv4 = true
+			} else {
+				forIPv6 = true
+			}
+		}
+		if forIPv4 && forIPv6 {
+			return nil, fmt.Errorf("SPAN filter %v mixes IPv4 and IPv6 networks", filter.Ip)
+		}
+		anyAddrs := []string{anyIPv4Addr + anyAddressPrefix, anyIPv6Addr + anyAddressPrefix}
+		switch {
+		case forIPv4:
+			anyAddrs = anyAddrs[:1]
+		case forIPv6:
+			anyAddrs = anyAddrs[1:]
+		}
+		for _, anyAddr := range anyAddrs {
+			translateAlias := func(addr string) string {
+				if addr == "" || addr == anyAddrAlias {
+					return anyAddr
+				}
+				return addr
+			}
+			out = append(out, &vppacl.ACL_Rule_IpRule{
+				Ip: &vppacl.ACL_Rule_IpRule_Ip{
+					SourceNetwork:      translateAlias(src),
+					DestinationNetwork: translateAlias(dst),
+					Protocol:           filter.Ip.Protocol,
+				},
+				Icmp: filter.Icmp,
+				Tcp:  filter.Tcp,
+				Udp:  filter.Udp,
+			})
+		}
+	}
+	return out, nil
+}
+
+// validateTunnel checks endpoints of the tunnel requested for REMOTE_SPAN.
+func validateTunnel(remoteSpan *pb.PuntRequest_RemoteSpan) error {
+	srcIP := net.ParseIP(remoteSpan.GetSrcAddress())
+	if srcIP == nil {
+		return fmt.Errorf("invalid source address of the REMOTE_SPAN tunnel: %q", remoteSpan.GetSrcAddress())
+	}
+	dstIP := net.ParseIP(remoteSpan.GetDstAddress())
+	if dstIP == nil {
+		return fmt.Errorf("invalid destination address of the REMOTE_SPAN tunnel: %q", remoteSpan.GetDstAddress())
+	}
+	if (srcIP.To4() == nil) != (dstIP.To4() == nil) {
+		return fmt.Errorf("REMOTE_SPAN tunnel endpoints %s and %s have different IP versions", srcIP, dstIP)
+	}
+	if remoteSpan.GetTunnelType() == pb.PuntRequest_RemoteSpan_ERSPAN && remoteSpan.GetSessionId() > 1023 {
+		return fmt.Errorf("ERSPAN session ID %d is out of range (10 bits)", remoteSpan.GetSessionId())
 	}
 	return nil
 }
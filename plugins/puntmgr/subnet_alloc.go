@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puntmgr
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"sort"
+
+	"github.com/apparentlymart/go-cidr/cidr"
+
+	pb "go.pantheon.tech/stonework/proto/puntmgr"
+)
+
+// ErrSubnetsExhausted is returned when all subnets from all CIDR pools configured for interconnects
+// are already allocated.
+var ErrSubnetsExhausted = errors.New("all subnets available for interconnects are allocated")
+
+// subnetAllocator allocates equally-sized subnets from one or more IPv4 CIDR pools.
+// Subnets are addressed by a global index, which is contiguous across pools (in the order of configuration).
+// Released subnets are kept in a free list and re-used before any subnet that was never allocated.
+// Subnets above the free list are free implicitly (unless marked as used), so that marking a high index
+// as used (e.g. restored from checkpoint) does not expand the free list.
+// With IPv6 pool configured, IPv6 subnet with the same index is allocated alongside (dual-stack).
+// Without IPv4 pools, subnets are allocated only from the IPv6 pool.
+type subnetAllocator struct {
+	pools      []*net.IPNet
+	poolSizes  []int // number of subnets in each pool
+	hostBits   int   // size of each subnet in host bits
+	poolV6     *net.IPNet
+	hostBitsV6 int
+	size       int // total number of subnets that can be allocated
+
+	used    map[int]struct{}
+	free    []int // released indexes lower than nextIdx, sorted
+	nextIdx int   // all indexes starting with this one are free unless they are in used
+}
+
+func newSubnetAllocator(pools []*net.IPNet, hostBits int,
+	poolV6 *net.IPNet, hostBitsV6 int) (*subnetAllocator, error) {
+	if len(pools) == 0 && poolV6 == nil {
+		return nil, errors.New("no CIDR is configured for subnet allocations")
+	}
+	a := &subnetAllocator{
+		hostBits:   hostBits,
+		poolV6:     poolV6,
+		hostBitsV6: hostBitsV6,
+		used:       make(map[int]struct{}),
+	}
+	for _, pool := range pools {
+		if pool.IP.To4() == nil {
+			return nil, fmt.Errorf("cidr %v for IPv4 address allocation is not IPv4", pool)
+		}
+		poolSize, err := subnetCount(pool, hostBits)
+		if err != nil {
+			return nil, err
+		}
+		for _, pool2 := range a.pools {
+			if pool2.Contains(pool.IP) || pool.Contains(pool2.IP) {
+				return nil, fmt.Errorf("cidr %v for address allocation overlaps with %v", pool, pool2)
+			}
+		}
+		if a.size > math.MaxInt32-poolSize {
+			poolSize = math.MaxInt32 - a.size
+		}
+		a.pools = append(a.pools, pool)
+		a.poolSizes = append(a.poolSizes, poolSize)
+		a.size += poolSize
+	}
+	if poolV6 != nil {
+		if poolV6.IP.To4() != nil {
+			return nil, fmt.Errorf("cidr %v for IPv6 address allocation is not IPv6", poolV6)
+		}
+		poolSize, err := subnetCount(poolV6, hostBitsV6)
+		if err != nil {
+			return nil, err
+		}
+		if len(pools) == 0 {
+			a.size = poolSize
+		} else if poolSize < a.size {
+			return nil, fmt.Errorf("cidr %v for IPv6 address allocation is too small "+
+				"(%d subnets needed to match IPv4 pools)", poolV6, a.size)
+		}
+	}
+	return a, nil
+}
+
+// subnetCount returns the number of subnets (with the given number of host bits) in the pool.
+func subnetCount(pool *net.IPNet, hostBits int) (int, error) {
+	ones, bits := pool.Mask.Size()
+	if bits-ones < hostBits {
+		return 0, fmt.Errorf("cidr %v for address allocation is too small", pool)
+	}
+	if bits-ones-hostBits >= 31 {
+		return math.MaxInt32, nil
+	}
+	return 1 << (bits - ones - hostBits), nil
+}
+
+// findFree returns the lowest free index which is not excluded. The index is not marked as used.
+func (a *subnetAllocator) findFree(exclude map[int]struct{}) (int, error) {
+	for _, idx := range a.free {
+		if _, excluded := exclude[idx]; !excluded {
+			return idx, nil
+		}
+	}
+	for idx := a.nextIdx; idx < a.size; idx++ {
+		if _, used := a.used[idx]; used {
+			continue
+		}
+		if _, excluded := exclude[idx]; !excluded {
+			return idx, nil
+		}
+	}
+	return 0, fmt.Errorf("%w (%d subnets in %v)", ErrSubnetsExhausted, a.size, a.allPools())
+}
+
+// isFree returns true if the subnet with the given index exists and is not allocated.
+func (a *subnetAllocator) isFree(idx int) bool {
+	if idx < 0 || idx >= a.size {
+		return false
+	}
+	_, used := a.used[idx]
+	return !used
+}
+
+// markUsed marks subnet with the given index as allocated.
+func (a *subnetAllocator) markUsed(idx int) {
+	if !a.isFree(idx) {
+		return
+	}
+	a.used[idx] = struct{}{}
+	if idx > a.nextIdx {
+		// indexes skipped over remain implicitly free
+		return
+	}
+	if idx == a.nextIdx {
+		for a.nextIdx++; a.nextIdx < a.size; a.nextIdx++ {
+			if _, used := a.used[a.nextIdx]; !used {
+				break
+			}
+		}
+		return
+	}
+	i := sort.SearchInts(a.free, idx)
+	if i < len(a.free) && a.free[i] == idx {
+		a.free = append(a.free[:i], a.free[i+1:]...)
+	}
+}
+
+// release returns subnet with the given index back to the allocator.
+func (a *subnetAllocator) release(idx int) {
+	if _, used := a.used[idx]; !used {
+		return
+	}
+	delete(a.used, idx)
+	if idx >= a.nextIdx {
+		return
+	}
+	i := sort.SearchInts(a.free, idx)
+	a.free = append(a.free, 0)
+	copy(a.free[i+1:], a.free[i:])
+	a.free[i] = idx
+	// shrink the free list from the top
+	for len(a.free) > 0 && a.free[len(a.free)-1] == a.nextIdx-1 {
+		a.free = a.free[:len(a.free)-1]
+		a.nextIdx--
+	}
+}
+
+// subnets returns IPv4 and IPv6 subnets with the given index. Subnet of a family for which there is
+// no pool configured is returned as nil.
+func (a *subnetAllocator) subnets(idx int) (subnetV4, subnetV6 *net.IPNet, err error) {
+	if idx < 0 || idx >= a.size {
+		return nil, nil, fmt.Errorf("subnet index %d is out of range", idx)
+	}
+	if len(a.pools) > 0 {
+		pool, poolIdx := a.poolOf(idx)
+		ones, bits := a.pools[pool].Mask.Size()
+		subnetV4, err = cidr.Subnet(a.pools[pool], bits-ones-a.hostBits, poolIdx)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if a.poolV6 != nil {
+		ones, bits := a.poolV6.Mask.Size()
+		subnetV6, err = cidr.Subnet(a.poolV6, bits-ones-a.hostBitsV6, idx)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return subnetV4, subnetV6, nil
+}
+
+// index returns index of the subnet which contains the given IP address.
+func (a *subnetAllocator) index(ip net.IP) (idx int, found bool) {
+	base := 0
+	for i, pool := range a.pools {
+		if poolIdx, inPool := subnetIndex(pool, ip, a.hostBits); inPool && poolIdx < a.poolSizes[i] {
+			return base + poolIdx, true
+		}
+		base += a.poolSizes[i]
+	}
+	if a.poolV6 != nil {
+		if idx, inPool := subnetIndex(a.poolV6, ip, a.hostBitsV6); inPool && idx < a.size {
+			return idx, true
+		}
+	}
+	return 0, false
+}
+
+// allPools returns all configured pools (IPv4 followed by IPv6).
+func (a *subnetAllocator) allPools() []*net.IPNet {
+	pools := a.pools
+	if a.poolV6 != nil {
+		pools = append(pools[:len(pools):len(pools)], a.poolV6)
+	}
+	return pools
+}
+
+// poolOf returns index of the IPv4 pool from which the subnet with the given index is allocated
+// and the index of the subnet within that pool.
+func (a *subnetAllocator) poolOf(idx int) (pool, poolIdx int) {
+	if idx < 0 {
+		return -1, 0
+	}
+	for i, poolSize := range a.poolSizes {
+		if idx < poolSize {
+			return i, idx
+		}
+		idx -= poolSize
+	}
+	return -1, 0
+}
+
+// usage returns the number of allocated subnets for each pool.
+// Subnets for which the reserved callback returns true are counted separately.
+func (a *subnetAllocator) usage(reserved func(idx int) bool) (usage []*pb.SubnetPoolUsage) {
+	for i, pool := range a.pools {
+		usage = append(usage, &pb.SubnetPoolUsage{
+			Cidr:  pool.String(),
+			Total: uint32(a.poolSizes[i]),
+		})
+	}
+	var usageV6 *pb.SubnetPoolUsage
+	if a.poolV6 != nil {
+		usageV6 = &pb.SubnetPoolUsage{
+			Cidr:  a.poolV6.String(),
+			Total: uint32(a.size),
+		}
+		usage = append(usage, usageV6)
+	}
+	for idx := range a.used {
+		var poolUsage []*pb.SubnetPoolUsage
+		if pool, _ := a.poolOf(idx); pool >= 0 {
+			poolUsage = append(poolUsage, usage[pool])
+		}
+		if usageV6 != nil {
+			poolUsage = append(poolUsage, usageV6)
+		}
+		for _, pu := range poolUsage {
+			if reserved != nil && reserved(idx) {
+				pu.Reserved++
+			} else {
+				pu.Allocated++
+			}
+		}
+	}
+	return usage
+}
+
+// subnetIndex returns index of the subnet (with the given number of host bits) carved out of the network
+// that contains the given IP address.
+func subnetIndex(network *net.IPNet, ip net.IP, hostBits int) (idx int, found bool) {
+	if !network.Contains(ip) {
+		return 0, false
+	}
+	netIP := network.IP.Mask(network.Mask)
+	if ip4 := ip.To4(); ip4 != nil && len(netIP) == net.IPv4len {
+		ip = ip4
+	} else {
+		ip = ip.To16()
+		netIP = netIP.To16()
+	}
+	offset := new(big.Int).Sub(new(big.Int).SetBytes(ip), new(big.Int).SetBytes(netIP))
+	offset.Rsh(offset, uint(hostBits))
+	if !offset.IsInt64() || offset.Int64() > math.MaxInt32 {
+		return 0, false
+	}
+	return int(offset.Int64()), true
+}
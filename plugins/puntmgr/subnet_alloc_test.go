@@ -0,0 +1,367 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puntmgr
+
+import (
+	"math"
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func parseCIDRs(cidrs ...string) (nets []*net.IPNet) {
+	for _, c := range cidrs {
+		_, ipNet, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}
+
+func newTestAllocator(t *testing.T, cidrs []string, cidrV6 string, hostBitsV6 int) *subnetAllocator {
+	var poolV6 *net.IPNet
+	if cidrV6 != "" {
+		poolV6 = parseCIDRs(cidrV6)[0]
+	}
+	a, err := newSubnetAllocator(parseCIDRs(cidrs...), 2, poolV6, hostBitsV6)
+	if err != nil {
+		t.Fatalf("failed to create subnet allocator: %v", err)
+	}
+	return a
+}
+
+// allocate finds the lowest free subnet and marks it as used.
+func allocate(a *subnetAllocator) (int, error) {
+	idx, err := a.findFree(nil)
+	if err == nil {
+		a.markUsed(idx)
+	}
+	return idx, err
+}
+
+func TestSubnetAllocatorConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		cidrs      []string
+		cidrV6     string
+		hostBitsV6 int
+		expErr     bool
+		expSize    int
+	}{
+		{name: "single pool", cidrs: []string{"10.0.0.0/24"}, expSize: 64},
+		{name: "multiple pools", cidrs: []string{"10.0.0.0/29", "10.1.0.0/30"}, expSize: 3},
+		{name: "IPv6 only", cidrV6: "fd00::/120", hostBitsV6: 2, expSize: 64},
+		{name: "dual-stack", cidrs: []string{"10.0.0.0/28"}, cidrV6: "fd00::/124", hostBitsV6: 1, expSize: 4},
+		{name: "no pool", expErr: true},
+		{name: "overlapping pools", cidrs: []string{"10.0.0.0/24", "10.0.0.128/25"}, expErr: true},
+		{name: "pool too small", cidrs: []string{"10.0.0.0/31"}, expErr: true},
+		{name: "IPv6 in IPv4 pools", cidrs: []string{"fd00::/120"}, expErr: true},
+		{name: "IPv4 as IPv6 pool", cidrV6: "10.0.0.0/24", hostBitsV6: 2, expErr: true},
+		{name: "IPv6 pool smaller than IPv4", cidrs: []string{"10.0.0.0/28"}, cidrV6: "fd00::/126",
+			hostBitsV6: 1, expErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			var poolV6 *net.IPNet
+			if test.cidrV6 != "" {
+				poolV6 = parseCIDRs(test.cidrV6)[0]
+			}
+			a, err := newSubnetAllocator(parseCIDRs(test.cidrs...), 2, poolV6, test.hostBitsV6)
+			if test.expErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(a.size).To(Equal(test.expSize))
+		})
+	}
+}
+
+func TestSubnetAllocatorExhaustion(t *testing.T) {
+	RegisterTestingT(t)
+	a := newTestAllocator(t, []string{"10.0.0.0/29", "10.1.0.0/30"}, "", 0)
+
+	for i := 0; i < 3; i++ {
+		idx, err := allocate(a)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(idx).To(Equal(i))
+	}
+	_, err := allocate(a)
+	Expect(err).To(MatchError(ErrSubnetsExhausted))
+
+	// excluded indexes are skipped, even if there is no other free subnet
+	a.release(1)
+	_, err = a.findFree(map[int]struct{}{1: {}})
+	Expect(err).To(MatchError(ErrSubnetsExhausted))
+	idx, err := allocate(a)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(idx).To(Equal(1))
+}
+
+func TestSubnetAllocatorReuse(t *testing.T) {
+	tests := []struct {
+		name        string
+		allocated   int   // subnets allocated first
+		markUsed    []int // then marked as used
+		released    []int // then released
+		expFree     []int
+		expNextIdx  int
+		expAllocate []int // order in which subnets are allocated afterwards
+	}{
+		{
+			name:        "released subnets are re-used first, lowest first",
+			allocated:   4,
+			released:    []int{2, 0},
+			expFree:     []int{0, 2},
+			expNextIdx:  4,
+			expAllocate: []int{0, 2, 4, 5},
+		},
+		{
+			name:        "free list shrinks from the top",
+			allocated:   4,
+			released:    []int{1, 2, 3},
+			expFree:     []int{},
+			expNextIdx:  1,
+			expAllocate: []int{1, 2},
+		},
+		{
+			name:        "top released only",
+			allocated:   4,
+			released:    []int{3},
+			expFree:     []int{},
+			expNextIdx:  3,
+			expAllocate: []int{3, 4},
+		},
+		{
+			name:        "marking used skips indexes",
+			markUsed:    []int{3},
+			expFree:     []int{},
+			expNextIdx:  0,
+			expAllocate: []int{0, 1, 2, 4},
+		},
+		{
+			name:        "marking used below skipped index",
+			markUsed:    []int{3, 1},
+			expFree:     []int{},
+			expNextIdx:  0,
+			expAllocate: []int{0, 2, 4},
+		},
+		{
+			name:        "released below index marked used",
+			allocated:   4,
+			markUsed:    []int{6},
+			released:    []int{1},
+			expFree:     []int{1},
+			expNextIdx:  4,
+			expAllocate: []int{1, 4, 5, 7},
+		},
+		{
+			name:        "skipped index released",
+			markUsed:    []int{3},
+			released:    []int{3},
+			expFree:     []int{},
+			expNextIdx:  0,
+			expAllocate: []int{0, 1, 2, 3},
+		},
+		{
+			name:        "next index moves over indexes marked used",
+			allocated:   1,
+			markUsed:    []int{2, 3, 1},
+			expFree:     []int{},
+			expNextIdx:  4,
+			expAllocate: []int{4},
+		},
+		{
+			name:        "release of unused and out-of-range subnet is ignored",
+			allocated:   2,
+			released:    []int{5, -1, 100},
+			expFree:     []int{},
+			expNextIdx:  2,
+			expAllocate: []int{2},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			a := newTestAllocator(t, []string{"10.0.0.0/27"}, "", 0)
+			for i := 0; i < test.allocated; i++ {
+				_, err := allocate(a)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			for _, idx := range test.markUsed {
+				a.markUsed(idx)
+				Expect(a.isFree(idx)).To(BeFalse())
+			}
+			for _, idx := range test.released {
+				a.release(idx)
+			}
+			Expect(a.free).To(ConsistOf(test.expFree))
+			Expect(a.nextIdx).To(Equal(test.expNextIdx))
+			for _, expIdx := range test.expAllocate {
+				idx, err := allocate(a)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(idx).To(Equal(expIdx))
+			}
+		})
+	}
+}
+
+func TestSubnetAllocatorPoolBoundaries(t *testing.T) {
+	RegisterTestingT(t)
+	a := newTestAllocator(t, []string{"10.0.0.0/29", "10.1.0.0/30", "10.2.0.0/28"}, "", 0)
+	Expect(a.size).To(Equal(7))
+
+	tests := []struct {
+		idx        int
+		expPool    int
+		expPoolIdx int
+		expSubnet  string
+	}{
+		{idx: 0, expPool: 0, expPoolIdx: 0, expSubnet: "10.0.0.0/30"},
+		{idx: 1, expPool: 0, expPoolIdx: 1, expSubnet: "10.0.0.4/30"},
+		{idx: 2, expPool: 1, expPoolIdx: 0, expSubnet: "10.1.0.0/30"},
+		{idx: 3, expPool: 2, expPoolIdx: 0, expSubnet: "10.2.0.0/30"},
+		{idx: 6, expPool: 2, expPoolIdx: 3, expSubnet: "10.2.0.12/30"},
+	}
+	for _, test := range tests {
+		pool, poolIdx := a.poolOf(test.idx)
+		Expect(pool).To(Equal(test.expPool), "pool of %d", test.idx)
+		Expect(poolIdx).To(Equal(test.expPoolIdx), "index within pool of %d", test.idx)
+		subnet, subnetV6, err := a.subnets(test.idx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(subnetV6).To(BeNil())
+		Expect(subnet.String()).To(Equal(test.expSubnet))
+		// every host IP of the subnet maps back to the same index
+		for host := 0; host < 4; host++ {
+			ip := make(net.IP, len(subnet.IP))
+			copy(ip, subnet.IP)
+			ip[len(ip)-1] += byte(host)
+			idx, found := a.index(ip)
+			Expect(found).To(BeTrue(), "index of %v", ip)
+			Expect(idx).To(Equal(test.idx), "index of %v", ip)
+		}
+	}
+
+	pool, _ := a.poolOf(7)
+	Expect(pool).To(Equal(-1))
+	_, _, err := a.subnets(7)
+	Expect(err).To(HaveOccurred())
+	_, _, err = a.subnets(-1)
+	Expect(err).To(HaveOccurred())
+	_, found := a.index(net.ParseIP("10.3.0.1"))
+	Expect(found).To(BeFalse())
+	Expect(a.isFree(7)).To(BeFalse())
+}
+
+func TestSubnetAllocatorDualStack(t *testing.T) {
+	RegisterTestingT(t)
+	a := newTestAllocator(t, []string{"10.0.0.0/29", "10.1.0.0/30"}, "fd00::/120", 1)
+	Expect(a.size).To(Equal(3))
+
+	tests := []struct {
+		idx         int
+		expSubnet   string
+		expSubnetV6 string
+	}{
+		{idx: 0, expSubnet: "10.0.0.0/30", expSubnetV6: "fd00::/127"},
+		{idx: 1, expSubnet: "10.0.0.4/30", expSubnetV6: "fd00::2/127"},
+		{idx: 2, expSubnet: "10.1.0.0/30", expSubnetV6: "fd00::4/127"},
+	}
+	for _, test := range tests {
+		subnet, subnetV6, err := a.subnets(test.idx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(subnet.String()).To(Equal(test.expSubnet))
+		Expect(subnetV6.String()).To(Equal(test.expSubnetV6))
+		idx, found := a.index(subnetV6.IP)
+		Expect(found).To(BeTrue())
+		Expect(idx).To(Equal(test.idx))
+	}
+	// IPv6 subnets beyond the size of IPv4 pools are not allocated
+	_, found := a.index(net.ParseIP("fd00::6"))
+	Expect(found).To(BeFalse())
+
+	// IPv6-only
+	a = newTestAllocator(t, nil, "fd00::/124", 2)
+	Expect(a.size).To(Equal(4))
+	idx, err := allocate(a)
+	Expect(err).ToNot(HaveOccurred())
+	subnet, subnetV6, err := a.subnets(idx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(subnet).To(BeNil())
+	Expect(subnetV6.String()).To(Equal("fd00::/126"))
+}
+
+func TestSubnetAllocatorUsage(t *testing.T) {
+	RegisterTestingT(t)
+	a := newTestAllocator(t, []string{"10.0.0.0/29", "10.1.0.0/30"}, "fd00::/120", 2)
+	a.markUsed(0)
+	a.markUsed(1)
+	a.markUsed(2)
+	usage := a.usage(func(idx int) bool {
+		return idx == 1
+	})
+	Expect(usage).To(HaveLen(3))
+	Expect(usage[0].Cidr).To(Equal("10.0.0.0/29"))
+	Expect(usage[0].Total).To(BeEquivalentTo(2))
+	Expect(usage[0].Allocated).To(BeEquivalentTo(1))
+	Expect(usage[0].Reserved).To(BeEquivalentTo(1))
+	Expect(usage[1].Cidr).To(Equal("10.1.0.0/30"))
+	Expect(usage[1].Total).To(BeEquivalentTo(1))
+	Expect(usage[1].Allocated).To(BeEquivalentTo(1))
+	Expect(usage[2].Cidr).To(Equal("fd00::/120"))
+	Expect(usage[2].Total).To(BeEquivalentTo(3))
+	Expect(usage[2].Allocated).To(BeEquivalentTo(2))
+	Expect(usage[2].Reserved).To(BeEquivalentTo(1))
+}
+
+func TestSubnetAllocatorHighIndex(t *testing.T) {
+	RegisterTestingT(t)
+	// /64 pool of /127 subnets (MaxInt32 subnets)
+	a := newTestAllocator(t, nil, "fd00::/64", 1)
+	Expect(a.size).To(Equal(math.MaxInt32))
+
+	// high index (e.g. restored from checkpoint) does not expand the free list
+	high := a.size - 1
+	a.markUsed(high)
+	Expect(a.isFree(high)).To(BeFalse())
+	Expect(a.free).To(BeEmpty())
+	Expect(a.nextIdx).To(Equal(0))
+	idx, err := allocate(a)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(idx).To(Equal(0))
+
+	a.release(high)
+	Expect(a.isFree(high)).To(BeTrue())
+	Expect(a.free).To(BeEmpty())
+	Expect(a.used).To(HaveLen(1))
+
+	// index marked used below the next index is removed from the free list
+	for i := 0; i < 3; i++ {
+		_, err = allocate(a)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	a.release(1)
+	a.release(2)
+	Expect(a.free).To(Equal([]int{1, 2}))
+	a.markUsed(1)
+	Expect(a.free).To(Equal([]int{2}))
+	Expect(a.nextIdx).To(Equal(4))
+}
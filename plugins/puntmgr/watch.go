@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puntmgr
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "go.pantheon.tech/stonework/proto/puntmgr"
+)
+
+// Maximum number of events buffered for a single watcher. Watcher which falls behind is disconnected.
+const watchEventBufSize = 256
+
+// puntWatcher receives events about state transitions of the selected punts.
+type puntWatcher struct {
+	cnfMsLabel string // empty = all CNFs
+	key        string // empty = all keys
+	events     chan *pb.PuntEvent
+	overflow   chan struct{} // closed when the watcher is not able to keep up with the events
+}
+
+func (w *puntWatcher) selects(id puntID) bool {
+	return (w.cnfMsLabel == "" || w.cnfMsLabel == id.cnfMsLabel) &&
+		(w.key == "" || w.key == id.key)
+}
+
+// puntInfo returns description of the punt for the read/watch API.
+// The method should be called with the plugin locked.
+func (p *Plugin) puntInfo(id puntID, punt *punt) *pb.PuntInfo {
+	info := &pb.PuntInfo{
+		Metadata:            punt.metadata,
+		State:               punt.state,
+		Request:             punt.request,
+		SharedInterconnects: p.icManager.GetSharedInterconnects(id),
+	}
+	if punt.err != nil {
+		info.Error = punt.err.Error()
+	}
+	return info
+}
+
+// selectPunts returns description of all punts selected by CNF label and key (empty = any),
+// ordered by the punt ID.
+// The method should be called with the plugin locked.
+func (p *Plugin) selectPunts(cnfMsLabel, key string) (punts []*pb.PuntInfo) {
+	selector := &puntWatcher{cnfMsLabel: cnfMsLabel, key: key}
+	var ids []puntID
+	for id := range p.punts {
+		if selector.selects(id) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].String() < ids[j].String()
+	})
+	for _, id := range ids {
+		punts = append(punts, p.puntInfo(id, p.punts[id]))
+	}
+	return punts
+}
+
+// publishPuntEvent sends event about state transition of the given punt to all watchers
+// that have the punt selected.
+// The method should be called with the plugin locked.
+func (p *Plugin) publishPuntEvent(id puntID, punt *punt, prevState pb.PuntState) {
+	var event *pb.PuntEvent
+	for watcher := range p.watchers {
+		if !watcher.selects(id) {
+			continue
+		}
+		if event == nil {
+			event = &pb.PuntEvent{
+				Punt:      p.puntInfo(id, punt),
+				PrevState: prevState,
+			}
+		}
+		select {
+		case watcher.events <- event:
+		default:
+			// never block punt processing by a slow watcher
+			close(watcher.overflow)
+			delete(p.watchers, watcher)
+		}
+	}
+}
+
+// ListPunts returns all punts known to the Punt Manager, optionally filtered by CNF and key.
+func (p *Plugin) ListPunts(_ context.Context, req *pb.ListPuntsReq) (*pb.ListPuntsResp, error) {
+	p.Lock()
+	defer p.Unlock()
+	return &pb.ListPuntsResp{
+		Punts: p.selectPunts(req.GetCnfMsLabel(), req.GetKey()),
+	}, nil
+}
+
+// WatchPunts streams state transitions of punts, optionally filtered by CNF and key.
+func (p *Plugin) WatchPunts(req *pb.WatchPuntsReq, stream pb.PuntManager_WatchPuntsServer) error {
+	watcher := &puntWatcher{
+		cnfMsLabel: req.GetCnfMsLabel(),
+		key:        req.GetKey(),
+		events:     make(chan *pb.PuntEvent, watchEventBufSize),
+		overflow:   make(chan struct{}),
+	}
+	p.Lock()
+	var current []*pb.PuntInfo
+	if req.GetWithCurrentState() {
+		current = p.selectPunts(watcher.cnfMsLabel, watcher.key)
+	}
+	p.watchers[watcher] = struct{}{}
+	p.Unlock()
+
+	defer func() {
+		p.Lock()
+		delete(p.watchers, watcher)
+		p.Unlock()
+	}()
+
+	for _, puntInfo := range current {
+		if err := stream.Send(&pb.PuntEvent{Punt: puntInfo}); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-watcher.overflow:
+			return status.Errorf(codes.ResourceExhausted,
+				"punt watcher was not able to keep up with the events (more than %d pending)", watchEventBufSize)
+		case event := <-watcher.events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
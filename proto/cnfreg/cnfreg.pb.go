@@ -94,6 +94,93 @@ func (CnfMode) EnumDescriptor() ([]byte, []int) {
 	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{0}
 }
 
+// ApiCapabilities describe version and features of the API between StoneWork and SW-Modules as implemented
+// by one side. They are exchanged during discovery/registration, so that StoneWork and SW-Modules
+// can be upgraded independently. Peers that predate the negotiation do not send capabilities at all.
+type ApiCapabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version of the API implemented by the peer.
+	ApiVersion uint32 `protobuf:"varint,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// The oldest API version of the other side the peer is able to work with.
+	MinApiVersion uint32 `protobuf:"varint,2,opt,name=min_api_version,json=minApiVersion,proto3" json:"min_api_version,omitempty"`
+	// Punt types supported by the Punt Manager of the peer.
+	PuntTypes []puntmgr.PuntRequest_PuntType `protobuf:"varint,3,rep,packed,name=punt_types,json=puntTypes,proto3,enum=puntmgr.PuntRequest_PuntType" json:"punt_types,omitempty"`
+	// Interconnect types supported by the Punt Manager of the peer.
+	InterconnectTypes []puntmgr.PuntRequest_InterconnectType `protobuf:"varint,4,rep,packed,name=interconnect_types,json=interconnectTypes,proto3,enum=puntmgr.PuntRequest_InterconnectType" json:"interconnect_types,omitempty"`
+	// RPCs served by the peer (full method names, e.g. "/cnfreg.CnfDiscovery/GetItemDependencies").
+	Rpcs []string `protobuf:"bytes,5,rep,name=rpcs,proto3" json:"rpcs,omitempty"`
+}
+
+func (x *ApiCapabilities) Reset() {
+	*x = ApiCapabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiCapabilities) ProtoMessage() {}
+
+func (x *ApiCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiCapabilities.ProtoReflect.Descriptor instead.
+func (*ApiCapabilities) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApiCapabilities) GetApiVersion() uint32 {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return 0
+}
+
+func (x *ApiCapabilities) GetMinApiVersion() uint32 {
+	if x != nil {
+		return x.MinApiVersion
+	}
+	return 0
+}
+
+func (x *ApiCapabilities) GetPuntTypes() []puntmgr.PuntRequest_PuntType {
+	if x != nil {
+		return x.PuntTypes
+	}
+	return nil
+}
+
+func (x *ApiCapabilities) GetInterconnectTypes() []puntmgr.PuntRequest_InterconnectType {
+	if x != nil {
+		return x.InterconnectTypes
+	}
+	return nil
+}
+
+func (x *ApiCapabilities) GetRpcs() []string {
+	if x != nil {
+		return x.Rpcs
+	}
+	return nil
+}
+
 // DiscoverCnfReq is sent by CNFRegistry of STONEWORK to discover a SW-Module CNF.
 type DiscoverCnfReq struct {
 	state         protoimpl.MessageState
@@ -106,12 +193,14 @@ type DiscoverCnfReq struct {
 	SwGrpcPort uint32 `protobuf:"varint,2,opt,name=sw_grpc_port,json=swGrpcPort,proto3" json:"sw_grpc_port,omitempty"`
 	// HTTP port on which StoneWork (client of this request) listens.
 	SwHttpPort uint32 `protobuf:"varint,3,opt,name=sw_http_port,json=swHttpPort,proto3" json:"sw_http_port,omitempty"`
+	// API capabilities of StoneWork.
+	SwCapabilities *ApiCapabilities `protobuf:"bytes,4,opt,name=sw_capabilities,json=swCapabilities,proto3" json:"sw_capabilities,omitempty"`
 }
 
 func (x *DiscoverCnfReq) Reset() {
 	*x = DiscoverCnfReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cnfreg_cnfreg_proto_msgTypes[0]
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -124,7 +213,7 @@ func (x *DiscoverCnfReq) String() string {
 func (*DiscoverCnfReq) ProtoMessage() {}
 
 func (x *DiscoverCnfReq) ProtoReflect() protoreflect.Message {
-	mi := &file_cnfreg_cnfreg_proto_msgTypes[0]
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -137,7 +226,7 @@ func (x *DiscoverCnfReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscoverCnfReq.ProtoReflect.Descriptor instead.
 func (*DiscoverCnfReq) Descriptor() ([]byte, []int) {
-	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{0}
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *DiscoverCnfReq) GetSwIpAddress() string {
@@ -161,6 +250,13 @@ func (x *DiscoverCnfReq) GetSwHttpPort() uint32 {
 	return 0
 }
 
+func (x *DiscoverCnfReq) GetSwCapabilities() *ApiCapabilities {
+	if x != nil {
+		return x.SwCapabilities
+	}
+	return nil
+}
+
 // DiscoverCnfResp is returned by STONEWORK_MODULE with information about CNF configuration models.
 type DiscoverCnfResp struct {
 	state         protoimpl.MessageState
@@ -170,12 +266,19 @@ type DiscoverCnfResp struct {
 	// Microservice label of the discovered CNF.
 	CnfMsLabel   string                         `protobuf:"bytes,1,opt,name=cnf_ms_label,json=cnfMsLabel,proto3" json:"cnf_ms_label,omitempty"`
 	ConfigModels []*DiscoverCnfResp_ConfigModel `protobuf:"bytes,4,rep,name=config_models,json=configModels,proto3" json:"config_models,omitempty"`
+	// API capabilities of the SW-Module.
+	Capabilities *ApiCapabilities `protobuf:"bytes,5,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// Models of operational state of the CNF, read by StoneWork using GetStateItems.
+	StateModels []*DiscoverCnfResp_StateModel `protobuf:"bytes,6,rep,name=state_models,json=stateModels,proto3" json:"state_models,omitempty"`
+	// Full names of custom gRPC services of the CNF (e.g. "bfd.BFDWatcher") that StoneWork
+	// should make available through its gRPC proxy.
+	GrpcServices []string `protobuf:"bytes,7,rep,name=grpc_services,json=grpcServices,proto3" json:"grpc_services,omitempty"`
 }
 
 func (x *DiscoverCnfResp) Reset() {
 	*x = DiscoverCnfResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cnfreg_cnfreg_proto_msgTypes[1]
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -188,7 +291,7 @@ func (x *DiscoverCnfResp) String() string {
 func (*DiscoverCnfResp) ProtoMessage() {}
 
 func (x *DiscoverCnfResp) ProtoReflect() protoreflect.Message {
-	mi := &file_cnfreg_cnfreg_proto_msgTypes[1]
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -201,7 +304,7 @@ func (x *DiscoverCnfResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscoverCnfResp.ProtoReflect.Descriptor instead.
 func (*DiscoverCnfResp) Descriptor() ([]byte, []int) {
-	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{1}
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *DiscoverCnfResp) GetCnfMsLabel() string {
@@ -218,6 +321,27 @@ func (x *DiscoverCnfResp) GetConfigModels() []*DiscoverCnfResp_ConfigModel {
 	return nil
 }
 
+func (x *DiscoverCnfResp) GetCapabilities() *ApiCapabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *DiscoverCnfResp) GetStateModels() []*DiscoverCnfResp_StateModel {
+	if x != nil {
+		return x.StateModels
+	}
+	return nil
+}
+
+func (x *DiscoverCnfResp) GetGrpcServices() []string {
+	if x != nil {
+		return x.GrpcServices
+	}
+	return nil
+}
+
 // ConfigItemDependency stores information about a single dependency of a configuration item.
 type ConfigItemDependency struct {
 	state         protoimpl.MessageState
@@ -235,7 +359,7 @@ type ConfigItemDependency struct {
 func (x *ConfigItemDependency) Reset() {
 	*x = ConfigItemDependency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cnfreg_cnfreg_proto_msgTypes[2]
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -248,7 +372,7 @@ func (x *ConfigItemDependency) String() string {
 func (*ConfigItemDependency) ProtoMessage() {}
 
 func (x *ConfigItemDependency) ProtoReflect() protoreflect.Message {
-	mi := &file_cnfreg_cnfreg_proto_msgTypes[2]
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +385,7 @@ func (x *ConfigItemDependency) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigItemDependency.ProtoReflect.Descriptor instead.
 func (*ConfigItemDependency) Descriptor() ([]byte, []int) {
-	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{2}
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ConfigItemDependency) GetLabel() string {
@@ -321,7 +445,7 @@ type GetDependenciesResp struct {
 func (x *GetDependenciesResp) Reset() {
 	*x = GetDependenciesResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cnfreg_cnfreg_proto_msgTypes[3]
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -334,7 +458,7 @@ func (x *GetDependenciesResp) String() string {
 func (*GetDependenciesResp) ProtoMessage() {}
 
 func (x *GetDependenciesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_cnfreg_cnfreg_proto_msgTypes[3]
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +471,7 @@ func (x *GetDependenciesResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDependenciesResp.ProtoReflect.Descriptor instead.
 func (*GetDependenciesResp) Descriptor() ([]byte, []int) {
-	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{3}
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetDependenciesResp) GetDependencies() []*ConfigItemDependency {
@@ -357,35 +481,35 @@ func (x *GetDependenciesResp) GetDependencies() []*ConfigItemDependency {
 	return nil
 }
 
-type DiscoverCnfResp_ConfigModel struct {
+// GetItemsInfoReq is sent by STONEWORK to obtain punt requests and/or dependencies of multiple configuration
+// items at once.
+type GetItemsInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ProtoName is a name of protobuf message representing the model.
-	ProtoName    string `protobuf:"bytes,1,opt,name=proto_name,json=protoName,proto3" json:"proto_name,omitempty"`
-	WithPunt     bool   `protobuf:"varint,2,opt,name=with_punt,json=withPunt,proto3" json:"with_punt,omitempty"`
-	WithRetrieve bool   `protobuf:"varint,3,opt,name=with_retrieve,json=withRetrieve,proto3" json:"with_retrieve,omitempty"`
-	WithDeps     bool   `protobuf:"varint,4,opt,name=with_deps,json=withDeps,proto3" json:"with_deps,omitempty"`
+	Items            []*generic.Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	WithPuntRequests bool            `protobuf:"varint,2,opt,name=with_punt_requests,json=withPuntRequests,proto3" json:"with_punt_requests,omitempty"`
+	WithDependencies bool            `protobuf:"varint,3,opt,name=with_dependencies,json=withDependencies,proto3" json:"with_dependencies,omitempty"`
 }
 
-func (x *DiscoverCnfResp_ConfigModel) Reset() {
-	*x = DiscoverCnfResp_ConfigModel{}
+func (x *GetItemsInfoReq) Reset() {
+	*x = GetItemsInfoReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cnfreg_cnfreg_proto_msgTypes[4]
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DiscoverCnfResp_ConfigModel) String() string {
+func (x *GetItemsInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiscoverCnfResp_ConfigModel) ProtoMessage() {}
+func (*GetItemsInfoReq) ProtoMessage() {}
 
-func (x *DiscoverCnfResp_ConfigModel) ProtoReflect() protoreflect.Message {
-	mi := &file_cnfreg_cnfreg_proto_msgTypes[4]
+func (x *GetItemsInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,64 +520,108 @@ func (x *DiscoverCnfResp_ConfigModel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiscoverCnfResp_ConfigModel.ProtoReflect.Descriptor instead.
-func (*DiscoverCnfResp_ConfigModel) Descriptor() ([]byte, []int) {
-	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use GetItemsInfoReq.ProtoReflect.Descriptor instead.
+func (*GetItemsInfoReq) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DiscoverCnfResp_ConfigModel) GetProtoName() string {
+func (x *GetItemsInfoReq) GetItems() []*generic.Item {
 	if x != nil {
-		return x.ProtoName
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *DiscoverCnfResp_ConfigModel) GetWithPunt() bool {
+func (x *GetItemsInfoReq) GetWithPuntRequests() bool {
 	if x != nil {
-		return x.WithPunt
+		return x.WithPuntRequests
 	}
 	return false
 }
 
-func (x *DiscoverCnfResp_ConfigModel) GetWithRetrieve() bool {
+func (x *GetItemsInfoReq) GetWithDependencies() bool {
 	if x != nil {
-		return x.WithRetrieve
+		return x.WithDependencies
 	}
 	return false
 }
 
-func (x *DiscoverCnfResp_ConfigModel) GetWithDeps() bool {
+// GetItemsInfoResp is returned by STONEWORK_MODULE with punt requests and/or dependencies of configuration items.
+type GetItemsInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Information about items, in the order of the request.
+	Items []*GetItemsInfoResp_ItemInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetItemsInfoResp) Reset() {
+	*x = GetItemsInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemsInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemsInfoResp) ProtoMessage() {}
+
+func (x *GetItemsInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemsInfoResp.ProtoReflect.Descriptor instead.
+func (*GetItemsInfoResp) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetItemsInfoResp) GetItems() []*GetItemsInfoResp_ItemInfo {
 	if x != nil {
-		return x.WithDeps
+		return x.Items
 	}
-	return false
+	return nil
 }
 
-type ConfigItemDependency_Key struct {
+// GetStateItemsReq is sent by STONEWORK to read operational state of a CNF.
+type GetStateItemsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// ProtoName of the state model.
+	ProtoName string `protobuf:"bytes,1,opt,name=proto_name,json=protoName,proto3" json:"proto_name,omitempty"`
 }
 
-func (x *ConfigItemDependency_Key) Reset() {
-	*x = ConfigItemDependency_Key{}
+func (x *GetStateItemsReq) Reset() {
+	*x = GetStateItemsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cnfreg_cnfreg_proto_msgTypes[5]
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConfigItemDependency_Key) String() string {
+func (x *GetStateItemsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigItemDependency_Key) ProtoMessage() {}
+func (*GetStateItemsReq) ProtoMessage() {}
 
-func (x *ConfigItemDependency_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_cnfreg_cnfreg_proto_msgTypes[5]
+func (x *GetStateItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -464,43 +632,44 @@ func (x *ConfigItemDependency_Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigItemDependency_Key.ProtoReflect.Descriptor instead.
-func (*ConfigItemDependency_Key) Descriptor() ([]byte, []int) {
-	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use GetStateItemsReq.ProtoReflect.Descriptor instead.
+func (*GetStateItemsReq) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ConfigItemDependency_Key) GetKey() string {
+func (x *GetStateItemsReq) GetProtoName() string {
 	if x != nil {
-		return x.Key
+		return x.ProtoName
 	}
 	return ""
 }
 
-type ConfigItemDependency_AnyOf struct {
+// GetStateItemsResp is returned by STONEWORK_MODULE with the current operational state of the model.
+type GetStateItemsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyPrefixes []string `protobuf:"bytes,1,rep,name=key_prefixes,json=keyPrefixes,proto3" json:"key_prefixes,omitempty"`
+	Items []*generic.Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *ConfigItemDependency_AnyOf) Reset() {
-	*x = ConfigItemDependency_AnyOf{}
+func (x *GetStateItemsResp) Reset() {
+	*x = GetStateItemsResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cnfreg_cnfreg_proto_msgTypes[6]
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConfigItemDependency_AnyOf) String() string {
+func (x *GetStateItemsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigItemDependency_AnyOf) ProtoMessage() {}
+func (*GetStateItemsResp) ProtoMessage() {}
 
-func (x *ConfigItemDependency_AnyOf) ProtoReflect() protoreflect.Message {
-	mi := &file_cnfreg_cnfreg_proto_msgTypes[6]
+func (x *GetStateItemsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,168 +680,1156 @@ func (x *ConfigItemDependency_AnyOf) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigItemDependency_AnyOf.ProtoReflect.Descriptor instead.
-func (*ConfigItemDependency_AnyOf) Descriptor() ([]byte, []int) {
-	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use GetStateItemsResp.ProtoReflect.Descriptor instead.
+func (*GetStateItemsResp) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ConfigItemDependency_AnyOf) GetKeyPrefixes() []string {
+func (x *GetStateItemsResp) GetItems() []*generic.Item {
 	if x != nil {
-		return x.KeyPrefixes
+		return x.Items
 	}
 	return nil
 }
 
-var File_cnfreg_cnfreg_proto protoreflect.FileDescriptor
+// ValidateItemResp is returned by STONEWORK_MODULE with the result of validation of a configuration item.
+type ValidateItemResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_cnfreg_cnfreg_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2f, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x1a, 0x15, 0x70,
-	0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x69, 0x63, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x78, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6e,
-	0x66, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x77, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x77, 0x49,
-	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x5f, 0x67,
-	0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
-	0x73, 0x77, 0x47, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77,
-	0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x73, 0x77, 0x48, 0x74, 0x74, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x8b, 0x02, 0x0a,
-	0x0f, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6e, 0x66, 0x5f, 0x6d, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6e, 0x66, 0x4d, 0x73, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6e, 0x66, 0x72,
-	0x65, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65,
-	0x73, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x0c,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x1a, 0x8b, 0x01, 0x0a,
-	0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77,
-	0x69, 0x74, 0x68, 0x5f, 0x70, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x77, 0x69, 0x74, 0x68, 0x50, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68,
-	0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x77, 0x69, 0x74, 0x68, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x44, 0x65, 0x70, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x14, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a,
-	0x05, 0x61, 0x6e, 0x79, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63,
-	0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x41, 0x6e, 0x79, 0x4f, 0x66,
-	0x48, 0x00, 0x52, 0x05, 0x61, 0x6e, 0x79, 0x6f, 0x66, 0x1a, 0x17, 0x0a, 0x03, 0x4b, 0x65, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x1a, 0x2a, 0x0a, 0x05, 0x41, 0x6e, 0x79, 0x4f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x6b,
-	0x65, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x42, 0x05,
-	0x0a, 0x03, 0x64, 0x65, 0x70, 0x22, 0x57, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x0c,
-	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
-	0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2a, 0x3e,
-	0x0a, 0x07, 0x43, 0x6e, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41,
-	0x4e, 0x44, 0x41, 0x4c, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x4f,
-	0x4e, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x01, 0x12,
-	0x0d, 0x0a, 0x09, 0x53, 0x54, 0x4f, 0x4e, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x02, 0x32, 0xd8,
-	0x01, 0x0a, 0x0c, 0x43, 0x6e, 0x66, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12,
-	0x3e, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x12, 0x16,
-	0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x43, 0x6e, 0x66, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e,
-	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x3e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x69, 0x63, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x15, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d,
-	0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
-	0x48, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
-	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x1b, 0x2e, 0x63,
-	0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x6f, 0x2e,
-	0x70, 0x61, 0x6e, 0x74, 0x68, 0x65, 0x6f, 0x6e, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x73, 0x74,
-	0x6f, 0x6e, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6e,
-	0x66, 0x72, 0x65, 0x67, 0x3b, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	// Validation error, empty if the item is valid.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// Names of the invalid fields (if known).
+	InvalidFields []string `protobuf:"bytes,2,rep,name=invalid_fields,json=invalidFields,proto3" json:"invalid_fields,omitempty"`
 }
 
-var (
-	file_cnfreg_cnfreg_proto_rawDescOnce sync.Once
-	file_cnfreg_cnfreg_proto_rawDescData = file_cnfreg_cnfreg_proto_rawDesc
-)
+func (x *ValidateItemResp) Reset() {
+	*x = ValidateItemResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_cnfreg_cnfreg_proto_rawDescGZIP() []byte {
-	file_cnfreg_cnfreg_proto_rawDescOnce.Do(func() {
-		file_cnfreg_cnfreg_proto_rawDescData = protoimpl.X.CompressGZIP(file_cnfreg_cnfreg_proto_rawDescData)
-	})
-	return file_cnfreg_cnfreg_proto_rawDescData
+func (x *ValidateItemResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_cnfreg_cnfreg_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cnfreg_cnfreg_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_cnfreg_cnfreg_proto_goTypes = []interface{}{
-	(CnfMode)(0),                        // 0: cnfreg.CnfMode
-	(*DiscoverCnfReq)(nil),              // 1: cnfreg.DiscoverCnfReq
-	(*DiscoverCnfResp)(nil),             // 2: cnfreg.DiscoverCnfResp
-	(*ConfigItemDependency)(nil),        // 3: cnfreg.ConfigItemDependency
-	(*GetDependenciesResp)(nil),         // 4: cnfreg.GetDependenciesResp
-	(*DiscoverCnfResp_ConfigModel)(nil), // 5: cnfreg.DiscoverCnfResp.ConfigModel
-	(*ConfigItemDependency_Key)(nil),    // 6: cnfreg.ConfigItemDependency.Key
-	(*ConfigItemDependency_AnyOf)(nil),  // 7: cnfreg.ConfigItemDependency.AnyOf
-	(*generic.Item)(nil),                // 8: ligato.generic.Item
-	(*puntmgr.PuntRequests)(nil),        // 9: puntmgr.PuntRequests
+func (*ValidateItemResp) ProtoMessage() {}
+
+func (x *ValidateItemResp) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_cnfreg_cnfreg_proto_depIdxs = []int32{
-	5, // 0: cnfreg.DiscoverCnfResp.config_models:type_name -> cnfreg.DiscoverCnfResp.ConfigModel
-	7, // 1: cnfreg.ConfigItemDependency.anyof:type_name -> cnfreg.ConfigItemDependency.AnyOf
-	3, // 2: cnfreg.GetDependenciesResp.dependencies:type_name -> cnfreg.ConfigItemDependency
-	1, // 3: cnfreg.CnfDiscovery.DiscoverCnf:input_type -> cnfreg.DiscoverCnfReq
-	8, // 4: cnfreg.CnfDiscovery.GetPuntRequests:input_type -> ligato.generic.Item
-	8, // 5: cnfreg.CnfDiscovery.GetItemDependencies:input_type -> ligato.generic.Item
-	2, // 6: cnfreg.CnfDiscovery.DiscoverCnf:output_type -> cnfreg.DiscoverCnfResp
-	9, // 7: cnfreg.CnfDiscovery.GetPuntRequests:output_type -> puntmgr.PuntRequests
-	4, // 8: cnfreg.CnfDiscovery.GetItemDependencies:output_type -> cnfreg.GetDependenciesResp
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+
+// Deprecated: Use ValidateItemResp.ProtoReflect.Descriptor instead.
+func (*ValidateItemResp) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{9}
 }
 
-func init() { file_cnfreg_cnfreg_proto_init() }
-func file_cnfreg_cnfreg_proto_init() {
-	if File_cnfreg_cnfreg_proto != nil {
-		return
+func (x *ValidateItemResp) GetError() string {
+	if x != nil {
+		return x.Error
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_cnfreg_cnfreg_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiscoverCnfReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_cnfreg_cnfreg_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiscoverCnfResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
+	return ""
+}
+
+func (x *ValidateItemResp) GetInvalidFields() []string {
+	if x != nil {
+		return x.InvalidFields
+	}
+	return nil
+}
+
+// RegisterCnfReq is sent by SW-Module CNF to CNFRegistry of STONEWORK to register itself
+// (alternative to the discovery through pid files, e.g. for SW-Modules not sharing filesystem with StoneWork).
+// It carries the same information as the pid file and the response to DiscoverCnf.
+type RegisterCnfReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PID of the SW-Module process.
+	Pid int32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Address (IP address or hostname) on which the SW-Module is reachable.
+	// If empty, the address from which the request was received is used.
+	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// gRPC port on which the SW-Module listens.
+	GrpcPort uint32 `protobuf:"varint,3,opt,name=grpc_port,json=grpcPort,proto3" json:"grpc_port,omitempty"`
+	// HTTP port on which the SW-Module listens.
+	HttpPort uint32 `protobuf:"varint,4,opt,name=http_port,json=httpPort,proto3" json:"http_port,omitempty"`
+	// Microservice label, configuration models and API capabilities of the SW-Module.
+	Cnf *DiscoverCnfResp `protobuf:"bytes,5,opt,name=cnf,proto3" json:"cnf,omitempty"`
+}
+
+func (x *RegisterCnfReq) Reset() {
+	*x = RegisterCnfReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterCnfReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterCnfReq) ProtoMessage() {}
+
+func (x *RegisterCnfReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterCnfReq.ProtoReflect.Descriptor instead.
+func (*RegisterCnfReq) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RegisterCnfReq) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *RegisterCnfReq) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *RegisterCnfReq) GetGrpcPort() uint32 {
+	if x != nil {
+		return x.GrpcPort
+	}
+	return 0
+}
+
+func (x *RegisterCnfReq) GetHttpPort() uint32 {
+	if x != nil {
+		return x.HttpPort
+	}
+	return 0
+}
+
+func (x *RegisterCnfReq) GetCnf() *DiscoverCnfResp {
+	if x != nil {
+		return x.Cnf
+	}
+	return nil
+}
+
+// RegisterCnfResp is returned by STONEWORK once the SW-Module is loaded.
+type RegisterCnfResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Interval (in milliseconds) in which the SW-Module should send heartbeats.
+	// Registration expires if heartbeats stop.
+	HeartbeatIntervalMs uint32 `protobuf:"varint,1,opt,name=heartbeat_interval_ms,json=heartbeatIntervalMs,proto3" json:"heartbeat_interval_ms,omitempty"`
+	// HTTP port on which StoneWork listens.
+	SwHttpPort uint32 `protobuf:"varint,2,opt,name=sw_http_port,json=swHttpPort,proto3" json:"sw_http_port,omitempty"`
+	// API capabilities of StoneWork.
+	SwCapabilities *ApiCapabilities `protobuf:"bytes,3,opt,name=sw_capabilities,json=swCapabilities,proto3" json:"sw_capabilities,omitempty"`
+}
+
+func (x *RegisterCnfResp) Reset() {
+	*x = RegisterCnfResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterCnfResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterCnfResp) ProtoMessage() {}
+
+func (x *RegisterCnfResp) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterCnfResp.ProtoReflect.Descriptor instead.
+func (*RegisterCnfResp) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RegisterCnfResp) GetHeartbeatIntervalMs() uint32 {
+	if x != nil {
+		return x.HeartbeatIntervalMs
+	}
+	return 0
+}
+
+func (x *RegisterCnfResp) GetSwHttpPort() uint32 {
+	if x != nil {
+		return x.SwHttpPort
+	}
+	return 0
+}
+
+func (x *RegisterCnfResp) GetSwCapabilities() *ApiCapabilities {
+	if x != nil {
+		return x.SwCapabilities
+	}
+	return nil
+}
+
+// DeregisterCnfReq is sent by SW-Module CNF to unload itself from StoneWork (e.g. before shutdown).
+type DeregisterCnfReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CnfMsLabel string `protobuf:"bytes,1,opt,name=cnf_ms_label,json=cnfMsLabel,proto3" json:"cnf_ms_label,omitempty"`
+}
+
+func (x *DeregisterCnfReq) Reset() {
+	*x = DeregisterCnfReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeregisterCnfReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterCnfReq) ProtoMessage() {}
+
+func (x *DeregisterCnfReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterCnfReq.ProtoReflect.Descriptor instead.
+func (*DeregisterCnfReq) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeregisterCnfReq) GetCnfMsLabel() string {
+	if x != nil {
+		return x.CnfMsLabel
+	}
+	return ""
+}
+
+type DeregisterCnfResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeregisterCnfResp) Reset() {
+	*x = DeregisterCnfResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeregisterCnfResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterCnfResp) ProtoMessage() {}
+
+func (x *DeregisterCnfResp) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterCnfResp.ProtoReflect.Descriptor instead.
+func (*DeregisterCnfResp) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{13}
+}
+
+// HeartbeatReq is periodically sent by registered SW-Module CNF to keep the registration alive.
+type HeartbeatReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CnfMsLabel string `protobuf:"bytes,1,opt,name=cnf_ms_label,json=cnfMsLabel,proto3" json:"cnf_ms_label,omitempty"`
+}
+
+func (x *HeartbeatReq) Reset() {
+	*x = HeartbeatReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatReq) ProtoMessage() {}
+
+func (x *HeartbeatReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatReq.ProtoReflect.Descriptor instead.
+func (*HeartbeatReq) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HeartbeatReq) GetCnfMsLabel() string {
+	if x != nil {
+		return x.CnfMsLabel
+	}
+	return ""
+}
+
+type HeartbeatResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// False if the SW-Module is not registered (e.g. the registration has expired or StoneWork has restarted),
+	// in which case the SW-Module should register again.
+	Registered bool `protobuf:"varint,1,opt,name=registered,proto3" json:"registered,omitempty"`
+}
+
+func (x *HeartbeatResp) Reset() {
+	*x = HeartbeatResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResp) ProtoMessage() {}
+
+func (x *HeartbeatResp) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResp.ProtoReflect.Descriptor instead.
+func (*HeartbeatResp) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HeartbeatResp) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
+// LeaseCnfIndexReq is sent by SW-Module CNF to CNFRegistry of STONEWORK during Init to obtain CNF index
+// (and ports derived from it) instead of using a statically assigned one.
+type LeaseCnfIndexReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CnfMsLabel string `protobuf:"bytes,1,opt,name=cnf_ms_label,json=cnfMsLabel,proto3" json:"cnf_ms_label,omitempty"`
+}
+
+func (x *LeaseCnfIndexReq) Reset() {
+	*x = LeaseCnfIndexReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseCnfIndexReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseCnfIndexReq) ProtoMessage() {}
+
+func (x *LeaseCnfIndexReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseCnfIndexReq.ProtoReflect.Descriptor instead.
+func (*LeaseCnfIndexReq) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LeaseCnfIndexReq) GetCnfMsLabel() string {
+	if x != nil {
+		return x.CnfMsLabel
+	}
+	return ""
+}
+
+// LeaseCnfIndexResp is returned by STONEWORK with the CNF index leased to the SW-Module.
+// The lease is persisted, i.e. the same index is returned for the microservice label every time.
+type LeaseCnfIndexResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CnfIndex uint32 `protobuf:"varint,1,opt,name=cnf_index,json=cnfIndex,proto3" json:"cnf_index,omitempty"`
+	// gRPC port on which the SW-Module should listen.
+	GrpcPort uint32 `protobuf:"varint,2,opt,name=grpc_port,json=grpcPort,proto3" json:"grpc_port,omitempty"`
+	// HTTP port on which the SW-Module should listen.
+	HttpPort uint32 `protobuf:"varint,3,opt,name=http_port,json=httpPort,proto3" json:"http_port,omitempty"`
+}
+
+func (x *LeaseCnfIndexResp) Reset() {
+	*x = LeaseCnfIndexResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseCnfIndexResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseCnfIndexResp) ProtoMessage() {}
+
+func (x *LeaseCnfIndexResp) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseCnfIndexResp.ProtoReflect.Descriptor instead.
+func (*LeaseCnfIndexResp) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LeaseCnfIndexResp) GetCnfIndex() uint32 {
+	if x != nil {
+		return x.CnfIndex
+	}
+	return 0
+}
+
+func (x *LeaseCnfIndexResp) GetGrpcPort() uint32 {
+	if x != nil {
+		return x.GrpcPort
+	}
+	return 0
+}
+
+func (x *LeaseCnfIndexResp) GetHttpPort() uint32 {
+	if x != nil {
+		return x.HttpPort
+	}
+	return 0
+}
+
+type DiscoverCnfResp_ConfigModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ProtoName is a name of protobuf message representing the model.
+	ProtoName    string `protobuf:"bytes,1,opt,name=proto_name,json=protoName,proto3" json:"proto_name,omitempty"`
+	WithPunt     bool   `protobuf:"varint,2,opt,name=with_punt,json=withPunt,proto3" json:"with_punt,omitempty"`
+	WithRetrieve bool   `protobuf:"varint,3,opt,name=with_retrieve,json=withRetrieve,proto3" json:"with_retrieve,omitempty"`
+	WithDeps     bool   `protobuf:"varint,4,opt,name=with_deps,json=withDeps,proto3" json:"with_deps,omitempty"`
+	WithValidate bool   `protobuf:"varint,5,opt,name=with_validate,json=withValidate,proto3" json:"with_validate,omitempty"`
+}
+
+func (x *DiscoverCnfResp_ConfigModel) Reset() {
+	*x = DiscoverCnfResp_ConfigModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoverCnfResp_ConfigModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverCnfResp_ConfigModel) ProtoMessage() {}
+
+func (x *DiscoverCnfResp_ConfigModel) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverCnfResp_ConfigModel.ProtoReflect.Descriptor instead.
+func (*DiscoverCnfResp_ConfigModel) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *DiscoverCnfResp_ConfigModel) GetProtoName() string {
+	if x != nil {
+		return x.ProtoName
+	}
+	return ""
+}
+
+func (x *DiscoverCnfResp_ConfigModel) GetWithPunt() bool {
+	if x != nil {
+		return x.WithPunt
+	}
+	return false
+}
+
+func (x *DiscoverCnfResp_ConfigModel) GetWithRetrieve() bool {
+	if x != nil {
+		return x.WithRetrieve
+	}
+	return false
+}
+
+func (x *DiscoverCnfResp_ConfigModel) GetWithDeps() bool {
+	if x != nil {
+		return x.WithDeps
+	}
+	return false
+}
+
+func (x *DiscoverCnfResp_ConfigModel) GetWithValidate() bool {
+	if x != nil {
+		return x.WithValidate
+	}
+	return false
+}
+
+type DiscoverCnfResp_StateModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ProtoName is a name of protobuf message representing the model (registered with the "metrics" class).
+	ProtoName string `protobuf:"bytes,1,opt,name=proto_name,json=protoName,proto3" json:"proto_name,omitempty"`
+}
+
+func (x *DiscoverCnfResp_StateModel) Reset() {
+	*x = DiscoverCnfResp_StateModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoverCnfResp_StateModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverCnfResp_StateModel) ProtoMessage() {}
+
+func (x *DiscoverCnfResp_StateModel) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverCnfResp_StateModel.ProtoReflect.Descriptor instead.
+func (*DiscoverCnfResp_StateModel) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *DiscoverCnfResp_StateModel) GetProtoName() string {
+	if x != nil {
+		return x.ProtoName
+	}
+	return ""
+}
+
+type ConfigItemDependency_Key struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *ConfigItemDependency_Key) Reset() {
+	*x = ConfigItemDependency_Key{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigItemDependency_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigItemDependency_Key) ProtoMessage() {}
+
+func (x *ConfigItemDependency_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigItemDependency_Key.ProtoReflect.Descriptor instead.
+func (*ConfigItemDependency_Key) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *ConfigItemDependency_Key) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ConfigItemDependency_AnyOf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyPrefixes []string `protobuf:"bytes,1,rep,name=key_prefixes,json=keyPrefixes,proto3" json:"key_prefixes,omitempty"`
+}
+
+func (x *ConfigItemDependency_AnyOf) Reset() {
+	*x = ConfigItemDependency_AnyOf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigItemDependency_AnyOf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigItemDependency_AnyOf) ProtoMessage() {}
+
+func (x *ConfigItemDependency_AnyOf) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigItemDependency_AnyOf.ProtoReflect.Descriptor instead.
+func (*ConfigItemDependency_AnyOf) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *ConfigItemDependency_AnyOf) GetKeyPrefixes() []string {
+	if x != nil {
+		return x.KeyPrefixes
+	}
+	return nil
+}
+
+type GetItemsInfoResp_ItemInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PuntRequests *puntmgr.PuntRequests   `protobuf:"bytes,1,opt,name=punt_requests,json=puntRequests,proto3" json:"punt_requests,omitempty"`
+	Dependencies []*ConfigItemDependency `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// Error obtaining the information about the item (other attributes are not set then).
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetItemsInfoResp_ItemInfo) Reset() {
+	*x = GetItemsInfoResp_ItemInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cnfreg_cnfreg_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemsInfoResp_ItemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemsInfoResp_ItemInfo) ProtoMessage() {}
+
+func (x *GetItemsInfoResp_ItemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cnfreg_cnfreg_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemsInfoResp_ItemInfo.ProtoReflect.Descriptor instead.
+func (*GetItemsInfoResp_ItemInfo) Descriptor() ([]byte, []int) {
+	return file_cnfreg_cnfreg_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *GetItemsInfoResp_ItemInfo) GetPuntRequests() *puntmgr.PuntRequests {
+	if x != nil {
+		return x.PuntRequests
+	}
+	return nil
+}
+
+func (x *GetItemsInfoResp_ItemInfo) GetDependencies() []*ConfigItemDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *GetItemsInfoResp_ItemInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_cnfreg_cnfreg_proto protoreflect.FileDescriptor
+
+var file_cnfreg_cnfreg_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2f, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x1a, 0x15, 0x70,
+	0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x69, 0x63, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x82, 0x02, 0x0a, 0x0f, 0x41, 0x70, 0x69, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x70, 0x69,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x61,
+	0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x3c, 0x0a, 0x0a, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x09, 0x70, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x54, 0x0a,
+	0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70, 0x75, 0x6e, 0x74,
+	0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x70, 0x63, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x70, 0x63, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x77,
+	0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x77, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20,
+	0x0a, 0x0c, 0x73, 0x77, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x77, 0x47, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x77, 0x48, 0x74, 0x74, 0x70, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x40, 0x0a, 0x0f, 0x73, 0x77, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6e,
+	0x66, 0x72, 0x65, 0x67, 0x2e, 0x41, 0x70, 0x69, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x0e, 0x73, 0x77, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x22, 0x86, 0x04, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6e, 0x66, 0x5f,
+	0x6d, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6e, 0x66, 0x4d, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6e, 0x66,
+	0x72, 0x65, 0x67, 0x2e, 0x41, 0x70, 0x69, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x45, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67,
+	0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x70, 0x63,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x67, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0xb0, 0x01,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x77, 0x69, 0x74, 0x68, 0x5f, 0x70, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x77, 0x69, 0x74, 0x68, 0x50, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74,
+	0x68, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x44, 0x65, 0x70, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x77,
+	0x69, 0x74, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x1a, 0x2b, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc8, 0x01,
+	0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x3a, 0x0a, 0x05, 0x61, 0x6e, 0x79, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
+	0x74, 0x65, 0x6d, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x41, 0x6e,
+	0x79, 0x4f, 0x66, 0x48, 0x00, 0x52, 0x05, 0x61, 0x6e, 0x79, 0x6f, 0x66, 0x1a, 0x17, 0x0a, 0x03,
+	0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x2a, 0x0a, 0x05, 0x41, 0x6e, 0x79, 0x4f, 0x66, 0x12, 0x21,
+	0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x42, 0x05, 0x0a, 0x03, 0x64, 0x65, 0x70, 0x22, 0x57, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x40, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x22, 0x98, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x77,
+	0x69, 0x74, 0x68, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x77, 0x69, 0x74, 0x68,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0xec, 0x01, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x9e, 0x01, 0x0a, 0x08, 0x49,
+	0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x75, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x0c, 0x70, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6e, 0x66, 0x72,
+	0x65, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3f,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x69, 0x63, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x4f, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6e, 0x66,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x74, 0x74, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x29,
+	0x0a, 0x03, 0x63, 0x6e, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6e,
+	0x66, 0x72, 0x65, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6e, 0x66,
+	0x52, 0x65, 0x73, 0x70, 0x52, 0x03, 0x63, 0x6e, 0x66, 0x22, 0xa9, 0x01, 0x0a, 0x0f, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a,
+	0x15, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x68, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d,
+	0x73, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x77, 0x48, 0x74, 0x74, 0x70, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x40, 0x0a, 0x0f, 0x73, 0x77, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x41, 0x70, 0x69, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0e, 0x73, 0x77, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x10, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6e, 0x66,
+	0x5f, 0x6d, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6e, 0x66, 0x4d, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x13, 0x0a, 0x11, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x30, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6e, 0x66, 0x5f, 0x6d, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6e, 0x66, 0x4d, 0x73, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x22, 0x2f, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x22, 0x34, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6e, 0x66, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6e, 0x66, 0x5f, 0x6d,
+	0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6e, 0x66, 0x4d, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x6a, 0x0a, 0x11, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x43, 0x6e, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6e, 0x66, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x63, 0x6e, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x67,
+	0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x67, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x74, 0x74,
+	0x70, 0x50, 0x6f, 0x72, 0x74, 0x2a, 0x3e, 0x0a, 0x07, 0x43, 0x6e, 0x66, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x4c, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x4f, 0x4e, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4d, 0x4f,
+	0x44, 0x55, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x54, 0x4f, 0x4e, 0x45, 0x57,
+	0x4f, 0x52, 0x4b, 0x10, 0x02, 0x32, 0xa1, 0x03, 0x0a, 0x0c, 0x43, 0x6e, 0x66, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x43, 0x6e, 0x66, 0x12, 0x16, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e,
+	0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43,
+	0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x1a,
+	0x15, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x14, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x49,
+	0x74, 0x65, 0x6d, 0x1a, 0x1b, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x3e, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69,
+	0x63, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x18, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x41, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x17, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6e, 0x66, 0x72,
+	0x65, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x18, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19,
+	0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x32, 0x93, 0x02, 0x0a, 0x0b, 0x43, 0x6e,
+	0x66, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x12, 0x16, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65,
+	0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x71,
+	0x1a, 0x17, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0d, 0x44, 0x65, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x12, 0x18, 0x2e, 0x63, 0x6e, 0x66,
+	0x72, 0x65, 0x67, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6e,
+	0x66, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x44, 0x65,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x38, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x14, 0x2e, 0x63,
+	0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0d, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x43, 0x6e, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x2e, 0x63, 0x6e, 0x66,
+	0x72, 0x65, 0x67, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6e, 0x66, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x2e, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x43, 0x6e, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x42,
+	0x30, 0x5a, 0x2e, 0x67, 0x6f, 0x2e, 0x70, 0x61, 0x6e, 0x74, 0x68, 0x65, 0x6f, 0x6e, 0x2e, 0x74,
+	0x65, 0x63, 0x68, 0x2f, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6e, 0x66, 0x72, 0x65, 0x67, 0x3b, 0x63, 0x6e, 0x66, 0x72, 0x65,
+	0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cnfreg_cnfreg_proto_rawDescOnce sync.Once
+	file_cnfreg_cnfreg_proto_rawDescData = file_cnfreg_cnfreg_proto_rawDesc
+)
+
+func file_cnfreg_cnfreg_proto_rawDescGZIP() []byte {
+	file_cnfreg_cnfreg_proto_rawDescOnce.Do(func() {
+		file_cnfreg_cnfreg_proto_rawDescData = protoimpl.X.CompressGZIP(file_cnfreg_cnfreg_proto_rawDescData)
+	})
+	return file_cnfreg_cnfreg_proto_rawDescData
+}
+
+var file_cnfreg_cnfreg_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cnfreg_cnfreg_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_cnfreg_cnfreg_proto_goTypes = []interface{}{
+	(CnfMode)(0),                              // 0: cnfreg.CnfMode
+	(*ApiCapabilities)(nil),                   // 1: cnfreg.ApiCapabilities
+	(*DiscoverCnfReq)(nil),                    // 2: cnfreg.DiscoverCnfReq
+	(*DiscoverCnfResp)(nil),                   // 3: cnfreg.DiscoverCnfResp
+	(*ConfigItemDependency)(nil),              // 4: cnfreg.ConfigItemDependency
+	(*GetDependenciesResp)(nil),               // 5: cnfreg.GetDependenciesResp
+	(*GetItemsInfoReq)(nil),                   // 6: cnfreg.GetItemsInfoReq
+	(*GetItemsInfoResp)(nil),                  // 7: cnfreg.GetItemsInfoResp
+	(*GetStateItemsReq)(nil),                  // 8: cnfreg.GetStateItemsReq
+	(*GetStateItemsResp)(nil),                 // 9: cnfreg.GetStateItemsResp
+	(*ValidateItemResp)(nil),                  // 10: cnfreg.ValidateItemResp
+	(*RegisterCnfReq)(nil),                    // 11: cnfreg.RegisterCnfReq
+	(*RegisterCnfResp)(nil),                   // 12: cnfreg.RegisterCnfResp
+	(*DeregisterCnfReq)(nil),                  // 13: cnfreg.DeregisterCnfReq
+	(*DeregisterCnfResp)(nil),                 // 14: cnfreg.DeregisterCnfResp
+	(*HeartbeatReq)(nil),                      // 15: cnfreg.HeartbeatReq
+	(*HeartbeatResp)(nil),                     // 16: cnfreg.HeartbeatResp
+	(*LeaseCnfIndexReq)(nil),                  // 17: cnfreg.LeaseCnfIndexReq
+	(*LeaseCnfIndexResp)(nil),                 // 18: cnfreg.LeaseCnfIndexResp
+	(*DiscoverCnfResp_ConfigModel)(nil),       // 19: cnfreg.DiscoverCnfResp.ConfigModel
+	(*DiscoverCnfResp_StateModel)(nil),        // 20: cnfreg.DiscoverCnfResp.StateModel
+	(*ConfigItemDependency_Key)(nil),          // 21: cnfreg.ConfigItemDependency.Key
+	(*ConfigItemDependency_AnyOf)(nil),        // 22: cnfreg.ConfigItemDependency.AnyOf
+	(*GetItemsInfoResp_ItemInfo)(nil),         // 23: cnfreg.GetItemsInfoResp.ItemInfo
+	(puntmgr.PuntRequest_PuntType)(0),         // 24: puntmgr.PuntRequest.PuntType
+	(puntmgr.PuntRequest_InterconnectType)(0), // 25: puntmgr.PuntRequest.InterconnectType
+	(*generic.Item)(nil),                      // 26: ligato.generic.Item
+	(*puntmgr.PuntRequests)(nil),              // 27: puntmgr.PuntRequests
+}
+var file_cnfreg_cnfreg_proto_depIdxs = []int32{
+	24, // 0: cnfreg.ApiCapabilities.punt_types:type_name -> puntmgr.PuntRequest.PuntType
+	25, // 1: cnfreg.ApiCapabilities.interconnect_types:type_name -> puntmgr.PuntRequest.InterconnectType
+	1,  // 2: cnfreg.DiscoverCnfReq.sw_capabilities:type_name -> cnfreg.ApiCapabilities
+	19, // 3: cnfreg.DiscoverCnfResp.config_models:type_name -> cnfreg.DiscoverCnfResp.ConfigModel
+	1,  // 4: cnfreg.DiscoverCnfResp.capabilities:type_name -> cnfreg.ApiCapabilities
+	20, // 5: cnfreg.DiscoverCnfResp.state_models:type_name -> cnfreg.DiscoverCnfResp.StateModel
+	22, // 6: cnfreg.ConfigItemDependency.anyof:type_name -> cnfreg.ConfigItemDependency.AnyOf
+	4,  // 7: cnfreg.GetDependenciesResp.dependencies:type_name -> cnfreg.ConfigItemDependency
+	26, // 8: cnfreg.GetItemsInfoReq.items:type_name -> ligato.generic.Item
+	23, // 9: cnfreg.GetItemsInfoResp.items:type_name -> cnfreg.GetItemsInfoResp.ItemInfo
+	26, // 10: cnfreg.GetStateItemsResp.items:type_name -> ligato.generic.Item
+	3,  // 11: cnfreg.RegisterCnfReq.cnf:type_name -> cnfreg.DiscoverCnfResp
+	1,  // 12: cnfreg.RegisterCnfResp.sw_capabilities:type_name -> cnfreg.ApiCapabilities
+	27, // 13: cnfreg.GetItemsInfoResp.ItemInfo.punt_requests:type_name -> puntmgr.PuntRequests
+	4,  // 14: cnfreg.GetItemsInfoResp.ItemInfo.dependencies:type_name -> cnfreg.ConfigItemDependency
+	2,  // 15: cnfreg.CnfDiscovery.DiscoverCnf:input_type -> cnfreg.DiscoverCnfReq
+	26, // 16: cnfreg.CnfDiscovery.GetPuntRequests:input_type -> ligato.generic.Item
+	26, // 17: cnfreg.CnfDiscovery.GetItemDependencies:input_type -> ligato.generic.Item
+	26, // 18: cnfreg.CnfDiscovery.ValidateItem:input_type -> ligato.generic.Item
+	6,  // 19: cnfreg.CnfDiscovery.GetItemsInfo:input_type -> cnfreg.GetItemsInfoReq
+	8,  // 20: cnfreg.CnfDiscovery.GetStateItems:input_type -> cnfreg.GetStateItemsReq
+	11, // 21: cnfreg.CnfRegistry.RegisterCnf:input_type -> cnfreg.RegisterCnfReq
+	13, // 22: cnfreg.CnfRegistry.DeregisterCnf:input_type -> cnfreg.DeregisterCnfReq
+	15, // 23: cnfreg.CnfRegistry.Heartbeat:input_type -> cnfreg.HeartbeatReq
+	17, // 24: cnfreg.CnfRegistry.LeaseCnfIndex:input_type -> cnfreg.LeaseCnfIndexReq
+	3,  // 25: cnfreg.CnfDiscovery.DiscoverCnf:output_type -> cnfreg.DiscoverCnfResp
+	27, // 26: cnfreg.CnfDiscovery.GetPuntRequests:output_type -> puntmgr.PuntRequests
+	5,  // 27: cnfreg.CnfDiscovery.GetItemDependencies:output_type -> cnfreg.GetDependenciesResp
+	10, // 28: cnfreg.CnfDiscovery.ValidateItem:output_type -> cnfreg.ValidateItemResp
+	7,  // 29: cnfreg.CnfDiscovery.GetItemsInfo:output_type -> cnfreg.GetItemsInfoResp
+	9,  // 30: cnfreg.CnfDiscovery.GetStateItems:output_type -> cnfreg.GetStateItemsResp
+	12, // 31: cnfreg.CnfRegistry.RegisterCnf:output_type -> cnfreg.RegisterCnfResp
+	14, // 32: cnfreg.CnfRegistry.DeregisterCnf:output_type -> cnfreg.DeregisterCnfResp
+	16, // 33: cnfreg.CnfRegistry.Heartbeat:output_type -> cnfreg.HeartbeatResp
+	18, // 34: cnfreg.CnfRegistry.LeaseCnfIndex:output_type -> cnfreg.LeaseCnfIndexResp
+	25, // [25:35] is the sub-list for method output_type
+	15, // [15:25] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_cnfreg_cnfreg_proto_init() }
+func file_cnfreg_cnfreg_proto_init() {
+	if File_cnfreg_cnfreg_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cnfreg_cnfreg_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiCapabilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverCnfReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
 			}
 		}
 		file_cnfreg_cnfreg_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigItemDependency); i {
+			switch v := v.(*DiscoverCnfResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -684,7 +1841,7 @@ func file_cnfreg_cnfreg_proto_init() {
 			}
 		}
 		file_cnfreg_cnfreg_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDependenciesResp); i {
+			switch v := v.(*ConfigItemDependency); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -696,7 +1853,7 @@ func file_cnfreg_cnfreg_proto_init() {
 			}
 		}
 		file_cnfreg_cnfreg_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiscoverCnfResp_ConfigModel); i {
+			switch v := v.(*GetDependenciesResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -708,7 +1865,7 @@ func file_cnfreg_cnfreg_proto_init() {
 			}
 		}
 		file_cnfreg_cnfreg_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigItemDependency_Key); i {
+			switch v := v.(*GetItemsInfoReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -720,6 +1877,186 @@ func file_cnfreg_cnfreg_proto_init() {
 			}
 		}
 		file_cnfreg_cnfreg_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemsInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateItemsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateItemResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterCnfReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterCnfResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregisterCnfReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregisterCnfResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseCnfIndexReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseCnfIndexResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverCnfResp_ConfigModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverCnfResp_StateModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigItemDependency_Key); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cnfreg_cnfreg_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConfigItemDependency_AnyOf); i {
 			case 0:
 				return &v.state
@@ -731,8 +2068,20 @@ func file_cnfreg_cnfreg_proto_init() {
 				return nil
 			}
 		}
+		file_cnfreg_cnfreg_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemsInfoResp_ItemInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_cnfreg_cnfreg_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_cnfreg_cnfreg_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*ConfigItemDependency_Key_)(nil),
 		(*ConfigItemDependency_Anyof)(nil),
 	}
@@ -742,9 +2091,9 @@ func file_cnfreg_cnfreg_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cnfreg_cnfreg_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   23,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_cnfreg_cnfreg_proto_goTypes,
 		DependencyIndexes: file_cnfreg_cnfreg_proto_depIdxs,
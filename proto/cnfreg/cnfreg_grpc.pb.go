@@ -34,6 +34,15 @@ type CnfDiscoveryClient interface {
 	// the set of dependencies of the given configuration item (apart from punt deps which are determined
 	// from punt requests).
 	GetItemDependencies(ctx context.Context, in *generic.Item, opts ...grpc.CallOption) (*GetDependenciesResp, error)
+	// ValidateItem is served by CNFRegistry of a SW-Module CNF and validates the given configuration item
+	// before it is applied.
+	ValidateItem(ctx context.Context, in *generic.Item, opts ...grpc.CallOption) (*ValidateItemResp, error)
+	// GetItemsInfo is served by CNFRegistry of a SW-Module CNF and returns punt requests and/or dependencies
+	// of multiple configuration items at once (batched variant of GetPuntRequests and GetItemDependencies).
+	GetItemsInfo(ctx context.Context, in *GetItemsInfoReq, opts ...grpc.CallOption) (*GetItemsInfoResp, error)
+	// GetStateItems is served by CNFRegistry of a SW-Module CNF and returns the current operational state
+	// of the given state model.
+	GetStateItems(ctx context.Context, in *GetStateItemsReq, opts ...grpc.CallOption) (*GetStateItemsResp, error)
 }
 
 type cnfDiscoveryClient struct {
@@ -71,6 +80,33 @@ func (c *cnfDiscoveryClient) GetItemDependencies(ctx context.Context, in *generi
 	return out, nil
 }
 
+func (c *cnfDiscoveryClient) ValidateItem(ctx context.Context, in *generic.Item, opts ...grpc.CallOption) (*ValidateItemResp, error) {
+	out := new(ValidateItemResp)
+	err := c.cc.Invoke(ctx, "/cnfreg.CnfDiscovery/ValidateItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cnfDiscoveryClient) GetItemsInfo(ctx context.Context, in *GetItemsInfoReq, opts ...grpc.CallOption) (*GetItemsInfoResp, error) {
+	out := new(GetItemsInfoResp)
+	err := c.cc.Invoke(ctx, "/cnfreg.CnfDiscovery/GetItemsInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cnfDiscoveryClient) GetStateItems(ctx context.Context, in *GetStateItemsReq, opts ...grpc.CallOption) (*GetStateItemsResp, error) {
+	out := new(GetStateItemsResp)
+	err := c.cc.Invoke(ctx, "/cnfreg.CnfDiscovery/GetStateItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CnfDiscoveryServer is the server API for CnfDiscovery service.
 // All implementations must embed UnimplementedCnfDiscoveryServer
 // for forward compatibility
@@ -85,6 +121,15 @@ type CnfDiscoveryServer interface {
 	// the set of dependencies of the given configuration item (apart from punt deps which are determined
 	// from punt requests).
 	GetItemDependencies(context.Context, *generic.Item) (*GetDependenciesResp, error)
+	// ValidateItem is served by CNFRegistry of a SW-Module CNF and validates the given configuration item
+	// before it is applied.
+	ValidateItem(context.Context, *generic.Item) (*ValidateItemResp, error)
+	// GetItemsInfo is served by CNFRegistry of a SW-Module CNF and returns punt requests and/or dependencies
+	// of multiple configuration items at once (batched variant of GetPuntRequests and GetItemDependencies).
+	GetItemsInfo(context.Context, *GetItemsInfoReq) (*GetItemsInfoResp, error)
+	// GetStateItems is served by CNFRegistry of a SW-Module CNF and returns the current operational state
+	// of the given state model.
+	GetStateItems(context.Context, *GetStateItemsReq) (*GetStateItemsResp, error)
 	mustEmbedUnimplementedCnfDiscoveryServer()
 }
 
@@ -101,6 +146,15 @@ func (UnimplementedCnfDiscoveryServer) GetPuntRequests(context.Context, *generic
 func (UnimplementedCnfDiscoveryServer) GetItemDependencies(context.Context, *generic.Item) (*GetDependenciesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetItemDependencies not implemented")
 }
+func (UnimplementedCnfDiscoveryServer) ValidateItem(context.Context, *generic.Item) (*ValidateItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateItem not implemented")
+}
+func (UnimplementedCnfDiscoveryServer) GetItemsInfo(context.Context, *GetItemsInfoReq) (*GetItemsInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemsInfo not implemented")
+}
+func (UnimplementedCnfDiscoveryServer) GetStateItems(context.Context, *GetStateItemsReq) (*GetStateItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateItems not implemented")
+}
 func (UnimplementedCnfDiscoveryServer) mustEmbedUnimplementedCnfDiscoveryServer() {}
 
 // UnsafeCnfDiscoveryServer may be embedded to opt out of forward compatibility for this service.
@@ -168,6 +222,60 @@ func _CnfDiscovery_GetItemDependencies_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CnfDiscovery_ValidateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(generic.Item)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CnfDiscoveryServer).ValidateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnfreg.CnfDiscovery/ValidateItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CnfDiscoveryServer).ValidateItem(ctx, req.(*generic.Item))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CnfDiscovery_GetItemsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemsInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CnfDiscoveryServer).GetItemsInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnfreg.CnfDiscovery/GetItemsInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CnfDiscoveryServer).GetItemsInfo(ctx, req.(*GetItemsInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CnfDiscovery_GetStateItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CnfDiscoveryServer).GetStateItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnfreg.CnfDiscovery/GetStateItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CnfDiscoveryServer).GetStateItems(ctx, req.(*GetStateItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CnfDiscovery_ServiceDesc is the grpc.ServiceDesc for CnfDiscovery service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -187,6 +295,222 @@ var CnfDiscovery_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetItemDependencies",
 			Handler:    _CnfDiscovery_GetItemDependencies_Handler,
 		},
+		{
+			MethodName: "ValidateItem",
+			Handler:    _CnfDiscovery_ValidateItem_Handler,
+		},
+		{
+			MethodName: "GetItemsInfo",
+			Handler:    _CnfDiscovery_GetItemsInfo_Handler,
+		},
+		{
+			MethodName: "GetStateItems",
+			Handler:    _CnfDiscovery_GetStateItems_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cnfreg/cnfreg.proto",
+}
+
+// CnfRegistryClient is the client API for CnfRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CnfRegistryClient interface {
+	// RegisterCnf loads the calling SW-Module into StoneWork.
+	// Repeated registration of already loaded SW-Module is handled as a restart of the SW-Module.
+	RegisterCnf(ctx context.Context, in *RegisterCnfReq, opts ...grpc.CallOption) (*RegisterCnfResp, error)
+	// DeregisterCnf removes the calling SW-Module from StoneWork.
+	DeregisterCnf(ctx context.Context, in *DeregisterCnfReq, opts ...grpc.CallOption) (*DeregisterCnfResp, error)
+	// Heartbeat keeps the registration of SW-Module alive.
+	Heartbeat(ctx context.Context, in *HeartbeatReq, opts ...grpc.CallOption) (*HeartbeatResp, error)
+	// LeaseCnfIndex leases CNF index (and ports) to the calling SW-Module.
+	LeaseCnfIndex(ctx context.Context, in *LeaseCnfIndexReq, opts ...grpc.CallOption) (*LeaseCnfIndexResp, error)
+}
+
+type cnfRegistryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCnfRegistryClient(cc grpc.ClientConnInterface) CnfRegistryClient {
+	return &cnfRegistryClient{cc}
+}
+
+func (c *cnfRegistryClient) RegisterCnf(ctx context.Context, in *RegisterCnfReq, opts ...grpc.CallOption) (*RegisterCnfResp, error) {
+	out := new(RegisterCnfResp)
+	err := c.cc.Invoke(ctx, "/cnfreg.CnfRegistry/RegisterCnf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cnfRegistryClient) DeregisterCnf(ctx context.Context, in *DeregisterCnfReq, opts ...grpc.CallOption) (*DeregisterCnfResp, error) {
+	out := new(DeregisterCnfResp)
+	err := c.cc.Invoke(ctx, "/cnfreg.CnfRegistry/DeregisterCnf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cnfRegistryClient) Heartbeat(ctx context.Context, in *HeartbeatReq, opts ...grpc.CallOption) (*HeartbeatResp, error) {
+	out := new(HeartbeatResp)
+	err := c.cc.Invoke(ctx, "/cnfreg.CnfRegistry/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cnfRegistryClient) LeaseCnfIndex(ctx context.Context, in *LeaseCnfIndexReq, opts ...grpc.CallOption) (*LeaseCnfIndexResp, error) {
+	out := new(LeaseCnfIndexResp)
+	err := c.cc.Invoke(ctx, "/cnfreg.CnfRegistry/LeaseCnfIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CnfRegistryServer is the server API for CnfRegistry service.
+// All implementations must embed UnimplementedCnfRegistryServer
+// for forward compatibility
+type CnfRegistryServer interface {
+	// RegisterCnf loads the calling SW-Module into StoneWork.
+	// Repeated registration of already loaded SW-Module is handled as a restart of the SW-Module.
+	RegisterCnf(context.Context, *RegisterCnfReq) (*RegisterCnfResp, error)
+	// DeregisterCnf removes the calling SW-Module from StoneWork.
+	DeregisterCnf(context.Context, *DeregisterCnfReq) (*DeregisterCnfResp, error)
+	// Heartbeat keeps the registration of SW-Module alive.
+	Heartbeat(context.Context, *HeartbeatReq) (*HeartbeatResp, error)
+	// LeaseCnfIndex leases CNF index (and ports) to the calling SW-Module.
+	LeaseCnfIndex(context.Context, *LeaseCnfIndexReq) (*LeaseCnfIndexResp, error)
+	mustEmbedUnimplementedCnfRegistryServer()
+}
+
+// UnimplementedCnfRegistryServer must be embedded to have forward compatible implementations.
+type UnimplementedCnfRegistryServer struct {
+}
+
+func (UnimplementedCnfRegistryServer) RegisterCnf(context.Context, *RegisterCnfReq) (*RegisterCnfResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCnf not implemented")
+}
+func (UnimplementedCnfRegistryServer) DeregisterCnf(context.Context, *DeregisterCnfReq) (*DeregisterCnfResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterCnf not implemented")
+}
+func (UnimplementedCnfRegistryServer) Heartbeat(context.Context, *HeartbeatReq) (*HeartbeatResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedCnfRegistryServer) LeaseCnfIndex(context.Context, *LeaseCnfIndexReq) (*LeaseCnfIndexResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseCnfIndex not implemented")
+}
+func (UnimplementedCnfRegistryServer) mustEmbedUnimplementedCnfRegistryServer() {}
+
+// UnsafeCnfRegistryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CnfRegistryServer will
+// result in compilation errors.
+type UnsafeCnfRegistryServer interface {
+	mustEmbedUnimplementedCnfRegistryServer()
+}
+
+func RegisterCnfRegistryServer(s grpc.ServiceRegistrar, srv CnfRegistryServer) {
+	s.RegisterService(&CnfRegistry_ServiceDesc, srv)
+}
+
+func _CnfRegistry_RegisterCnf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCnfReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CnfRegistryServer).RegisterCnf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnfreg.CnfRegistry/RegisterCnf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CnfRegistryServer).RegisterCnf(ctx, req.(*RegisterCnfReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CnfRegistry_DeregisterCnf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeregisterCnfReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CnfRegistryServer).DeregisterCnf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnfreg.CnfRegistry/DeregisterCnf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CnfRegistryServer).DeregisterCnf(ctx, req.(*DeregisterCnfReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CnfRegistry_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CnfRegistryServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnfreg.CnfRegistry/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CnfRegistryServer).Heartbeat(ctx, req.(*HeartbeatReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CnfRegistry_LeaseCnfIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseCnfIndexReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CnfRegistryServer).LeaseCnfIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cnfreg.CnfRegistry/LeaseCnfIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CnfRegistryServer).LeaseCnfIndex(ctx, req.(*LeaseCnfIndexReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CnfRegistry_ServiceDesc is the grpc.ServiceDesc for CnfRegistry service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CnfRegistry_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cnfreg.CnfRegistry",
+	HandlerType: (*CnfRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterCnf",
+			Handler:    _CnfRegistry_RegisterCnf_Handler,
+		},
+		{
+			MethodName: "DeregisterCnf",
+			Handler:    _CnfRegistry_DeregisterCnf_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _CnfRegistry_Heartbeat_Handler,
+		},
+		{
+			MethodName: "LeaseCnfIndex",
+			Handler:    _CnfRegistry_LeaseCnfIndex_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cnfreg/cnfreg.proto",
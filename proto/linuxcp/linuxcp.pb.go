@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: linuxcp/linuxcp.proto
+
+package vpp_linuxcp
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LinuxCPInterfacePair_HostIfType int32
+
+const (
+	LinuxCPInterfacePair_TAP LinuxCPInterfacePair_HostIfType = 0
+	LinuxCPInterfacePair_TUN LinuxCPInterfacePair_HostIfType = 1
+)
+
+// Enum value maps for LinuxCPInterfacePair_HostIfType.
+var (
+	LinuxCPInterfacePair_HostIfType_name = map[int32]string{
+		0: "TAP",
+		1: "TUN",
+	}
+	LinuxCPInterfacePair_HostIfType_value = map[string]int32{
+		"TAP": 0,
+		"TUN": 1,
+	}
+)
+
+func (x LinuxCPInterfacePair_HostIfType) Enum() *LinuxCPInterfacePair_HostIfType {
+	p := new(LinuxCPInterfacePair_HostIfType)
+	*p = x
+	return p
+}
+
+func (x LinuxCPInterfacePair_HostIfType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinuxCPInterfacePair_HostIfType) Descriptor() protoreflect.EnumDescriptor {
+	return file_linuxcp_linuxcp_proto_enumTypes[0].Descriptor()
+}
+
+func (LinuxCPInterfacePair_HostIfType) Type() protoreflect.EnumType {
+	return &file_linuxcp_linuxcp_proto_enumTypes[0]
+}
+
+func (x LinuxCPInterfacePair_HostIfType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinuxCPInterfacePair_HostIfType.Descriptor instead.
+func (LinuxCPInterfacePair_HostIfType) EnumDescriptor() ([]byte, []int) {
+	return file_linuxcp_linuxcp_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// LinuxCPInterfacePair mirrors a VPP interface into Linux using the VPP linux-cp plugin.
+// VPP creates the host interface (TAP or TUN) and punts all packets destined to the host (ARP/ND,
+// routing protocols, local traffic) through it, packets sent by the host are transmitted out
+// of the VPP interface.
+type LinuxCPInterfacePair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the VPP interface to mirror (the pair is identified by this interface)
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Name of the host interface (max 15 characters)
+	HostIfName string `protobuf:"bytes,2,opt,name=host_if_name,json=hostIfName,proto3" json:"host_if_name,omitempty"`
+	// Type of the host interface, TUN can be used only for L3 (point-to-point) VPP interfaces
+	HostIfType LinuxCPInterfacePair_HostIfType `protobuf:"varint,3,opt,name=host_if_type,json=hostIfType,proto3,enum=vpp.linuxcp.LinuxCPInterfacePair_HostIfType" json:"host_if_type,omitempty"`
+	// Named network namespace (see "ip netns") where the host interface is created (max 31 characters).
+	// If empty, the default namespace of the linux-cp plugin is used.
+	Netns string `protobuf:"bytes,4,opt,name=netns,proto3" json:"netns,omitempty"`
+}
+
+func (x *LinuxCPInterfacePair) Reset() {
+	*x = LinuxCPInterfacePair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_linuxcp_linuxcp_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinuxCPInterfacePair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinuxCPInterfacePair) ProtoMessage() {}
+
+func (x *LinuxCPInterfacePair) ProtoReflect() protoreflect.Message {
+	mi := &file_linuxcp_linuxcp_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinuxCPInterfacePair.ProtoReflect.Descriptor instead.
+func (*LinuxCPInterfacePair) Descriptor() ([]byte, []int) {
+	return file_linuxcp_linuxcp_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LinuxCPInterfacePair) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *LinuxCPInterfacePair) GetHostIfName() string {
+	if x != nil {
+		return x.HostIfName
+	}
+	return ""
+}
+
+func (x *LinuxCPInterfacePair) GetHostIfType() LinuxCPInterfacePair_HostIfType {
+	if x != nil {
+		return x.HostIfType
+	}
+	return LinuxCPInterfacePair_TAP
+}
+
+func (x *LinuxCPInterfacePair) GetNetns() string {
+	if x != nil {
+		return x.Netns
+	}
+	return ""
+}
+
+var File_linuxcp_linuxcp_proto protoreflect.FileDescriptor
+
+var file_linuxcp_linuxcp_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63, 0x70, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x69, 0x6e,
+	0x75, 0x78, 0x63, 0x70, 0x22, 0xdc, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x43, 0x50,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a,
+	0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63,
+	0x70, 0x2e, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x43, 0x50, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x50, 0x61, 0x69, 0x72, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x65, 0x74, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x65,
+	0x74, 0x6e, 0x73, 0x22, 0x1e, 0x0a, 0x0a, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x55,
+	0x4e, 0x10, 0x01, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x70, 0x61, 0x6e, 0x74, 0x68, 0x65,
+	0x6f, 0x6e, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63, 0x70, 0x3b,
+	0x76, 0x70, 0x70, 0x5f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_linuxcp_linuxcp_proto_rawDescOnce sync.Once
+	file_linuxcp_linuxcp_proto_rawDescData = file_linuxcp_linuxcp_proto_rawDesc
+)
+
+func file_linuxcp_linuxcp_proto_rawDescGZIP() []byte {
+	file_linuxcp_linuxcp_proto_rawDescOnce.Do(func() {
+		file_linuxcp_linuxcp_proto_rawDescData = protoimpl.X.CompressGZIP(file_linuxcp_linuxcp_proto_rawDescData)
+	})
+	return file_linuxcp_linuxcp_proto_rawDescData
+}
+
+var file_linuxcp_linuxcp_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_linuxcp_linuxcp_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_linuxcp_linuxcp_proto_goTypes = []interface{}{
+	(LinuxCPInterfacePair_HostIfType)(0), // 0: vpp.linuxcp.LinuxCPInterfacePair.HostIfType
+	(*LinuxCPInterfacePair)(nil),         // 1: vpp.linuxcp.LinuxCPInterfacePair
+}
+var file_linuxcp_linuxcp_proto_depIdxs = []int32{
+	0, // 0: vpp.linuxcp.LinuxCPInterfacePair.host_if_type:type_name -> vpp.linuxcp.LinuxCPInterfacePair.HostIfType
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_linuxcp_linuxcp_proto_init() }
+func file_linuxcp_linuxcp_proto_init() {
+	if File_linuxcp_linuxcp_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_linuxcp_linuxcp_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinuxCPInterfacePair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_linuxcp_linuxcp_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_linuxcp_linuxcp_proto_goTypes,
+		DependencyIndexes: file_linuxcp_linuxcp_proto_depIdxs,
+		EnumInfos:         file_linuxcp_linuxcp_proto_enumTypes,
+		MessageInfos:      file_linuxcp_linuxcp_proto_msgTypes,
+	}.Build()
+	File_linuxcp_linuxcp_proto = out.File
+	file_linuxcp_linuxcp_proto_rawDesc = nil
+	file_linuxcp_linuxcp_proto_goTypes = nil
+	file_linuxcp_linuxcp_proto_depIdxs = nil
+}
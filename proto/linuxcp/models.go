@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_linuxcp
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the name of the module used for models.
+const ModuleName = "vpp.linuxcp"
+
+var ModelLinuxCPInterfacePair models.KnownModel
+
+func init() {
+	// models.Register requires protoreflect capabilities, so we initialize them first
+	file_linuxcp_linuxcp_proto_init()
+
+	ModelLinuxCPInterfacePair = models.Register(&LinuxCPInterfacePair{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v1",
+		Type:    "interface-pair",
+	}, models.WithNameTemplate("{{.Interface}}"))
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Copyright 2023 PANTHEON.tech
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ndproxy/ndproxy.proto
+
+package vpp_ndproxy
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NDProxyInterface enables IPv6 ND proxy on a VPP interface. VPP then replies to neighbor solicitations
+// received through the interface for targets reachable via other interfaces. It is the IPv6 counterpart
+// of the proxy ARP.
+type NDProxyInterface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the VPP interface
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+}
+
+func (x *NDProxyInterface) Reset() {
+	*x = NDProxyInterface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ndproxy_ndproxy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NDProxyInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NDProxyInterface) ProtoMessage() {}
+
+func (x *NDProxyInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_ndproxy_ndproxy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NDProxyInterface.ProtoReflect.Descriptor instead.
+func (*NDProxyInterface) Descriptor() ([]byte, []int) {
+	return file_ndproxy_ndproxy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NDProxyInterface) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+var File_ndproxy_ndproxy_proto protoreflect.FileDescriptor
+
+var file_ndproxy_ndproxy_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x64, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x22, 0x30, 0x0a, 0x10, 0x4e, 0x44, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x70, 0x61, 0x6e,
+	0x74, 0x68, 0x65, 0x6f, 0x6e, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x73, 0x74, 0x6f, 0x6e, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6e, 0x64, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ndproxy_ndproxy_proto_rawDescOnce sync.Once
+	file_ndproxy_ndproxy_proto_rawDescData = file_ndproxy_ndproxy_proto_rawDesc
+)
+
+func file_ndproxy_ndproxy_proto_rawDescGZIP() []byte {
+	file_ndproxy_ndproxy_proto_rawDescOnce.Do(func() {
+		file_ndproxy_ndproxy_proto_rawDescData = protoimpl.X.CompressGZIP(file_ndproxy_ndproxy_proto_rawDescData)
+	})
+	return file_ndproxy_ndproxy_proto_rawDescData
+}
+
+var file_ndproxy_ndproxy_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ndproxy_ndproxy_proto_goTypes = []interface{}{
+	(*NDProxyInterface)(nil), // 0: vpp.ndproxy.NDProxyInterface
+}
+var file_ndproxy_ndproxy_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_ndproxy_ndproxy_proto_init() }
+func file_ndproxy_ndproxy_proto_init() {
+	if File_ndproxy_ndproxy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ndproxy_ndproxy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NDProxyInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ndproxy_ndproxy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ndproxy_ndproxy_proto_goTypes,
+		DependencyIndexes: file_ndproxy_ndproxy_proto_depIdxs,
+		MessageInfos:      file_ndproxy_ndproxy_proto_msgTypes,
+	}.Build()
+	File_ndproxy_ndproxy_proto = out.File
+	file_ndproxy_ndproxy_proto_rawDesc = nil
+	file_ndproxy_ndproxy_proto_goTypes = nil
+	file_ndproxy_ndproxy_proto_depIdxs = nil
+}
@@ -50,6 +50,8 @@ const (
 	PuntState_CREATED PuntState = 2
 	// Punt is removed and no longer available (including the metadata).
 	PuntState_DELETED PuntState = 3
+	// Configuration of the punt failed. Punt can be re-added to retry.
+	PuntState_FAILED PuntState = 4
 )
 
 // Enum value maps for PuntState.
@@ -59,12 +61,14 @@ var (
 		1: "INIT",
 		2: "CREATED",
 		3: "DELETED",
+		4: "FAILED",
 	}
 	PuntState_value = map[string]int32{
 		"UNKNOWN": 0,
 		"INIT":    1,
 		"CREATED": 2,
 		"DELETED": 3,
+		"FAILED":  4,
 	}
 )
 
@@ -130,19 +134,36 @@ const (
 	// Basically it has the same goal as ABX, but ABX can't be used for ISIS protocol packets as packets
 	// for this protocol get dropped in VPP before reaching ACL VPP node.
 	PuntRequest_ISISX PuntRequest_PuntType = 7
+	// Copy traffic arriving and/or leaving via L2/L3 interface (like SPAN) and send it over GRE or ERSPAN tunnel
+	// to a remote destination (e.g. traffic analyzer running on another host).
+	// Interconnect type of the request is not used, the interconnect is always the tunnel.
+	PuntRequest_REMOTE_SPAN PuntRequest_PuntType = 8
+	// Redirect IP packets punted by VPP (i.e. packets destined to VPP itself which are not handled by VPP,
+	// e.g. BGP or OSPF) into the Linux network stack or into a memif-enabled CNF using "ip punt redirect":
+	//   vpp-interface with IP  -- punt redirect --> vpp memif/tap interface <-> Linux Tap / CNF memif
+	PuntRequest_IP_REDIRECT PuntRequest_PuntType = 9
+	// Mirror L3 VPP interface into the network namespace of the CNF using the VPP linux-cp plugin:
+	//   vpp-interface with IP  <-- linux-cp pair --> Linux Tap (host interface) inside the CNF
+	// Unlike ABX and ISISX, all packets destined to the interface (incl. ARP/ND and routing protocols)
+	// are delivered to Linux natively. Interconnect type of the request is not used, the host interface
+	// is always TAP.
+	PuntRequest_LINUX_CP PuntRequest_PuntType = 10
 )
 
 // Enum value maps for PuntRequest_PuntType.
 var (
 	PuntRequest_PuntType_name = map[int32]string{
-		0: "NO_PUNT",
-		1: "HAIRPIN_XCONNECT",
-		2: "HAIRPIN",
-		3: "SPAN",
-		4: "ABX",
-		5: "PUNT_TO_SOCKET",
-		6: "DHCP_PROXY",
-		7: "ISISX",
+		0:  "NO_PUNT",
+		1:  "HAIRPIN_XCONNECT",
+		2:  "HAIRPIN",
+		3:  "SPAN",
+		4:  "ABX",
+		5:  "PUNT_TO_SOCKET",
+		6:  "DHCP_PROXY",
+		7:  "ISISX",
+		8:  "REMOTE_SPAN",
+		9:  "IP_REDIRECT",
+		10: "LINUX_CP",
 	}
 	PuntRequest_PuntType_value = map[string]int32{
 		"NO_PUNT":          0,
@@ -153,6 +174,9 @@ var (
 		"PUNT_TO_SOCKET":   5,
 		"DHCP_PROXY":       6,
 		"ISISX":            7,
+		"REMOTE_SPAN":      8,
+		"IP_REDIRECT":      9,
+		"LINUX_CP":         10,
 	}
 )
 
@@ -193,6 +217,11 @@ const (
 	PuntRequest_MEMIF PuntRequest_InterconnectType = 1
 	// Exchange packets between VPP and a CNF over AF-UNIX socket.
 	PuntRequest_AF_UNIX PuntRequest_InterconnectType = 2
+	// Interconnect VPP with the Linux network stack using veth pair, with one end inside the network namespace
+	// of the CNF and the other end attached to VPP as af_packet (host) interface.
+	PuntRequest_AF_PACKET PuntRequest_InterconnectType = 3
+	// Like AF_PACKET, but the veth end is attached to VPP as AF_XDP interface.
+	PuntRequest_AF_XDP PuntRequest_InterconnectType = 4
 )
 
 // Enum value maps for PuntRequest_InterconnectType.
@@ -201,11 +230,15 @@ var (
 		0: "TAP",
 		1: "MEMIF",
 		2: "AF_UNIX",
+		3: "AF_PACKET",
+		4: "AF_XDP",
 	}
 	PuntRequest_InterconnectType_value = map[string]int32{
-		"TAP":     0,
-		"MEMIF":   1,
-		"AF_UNIX": 2,
+		"TAP":       0,
+		"MEMIF":     1,
+		"AF_UNIX":   2,
+		"AF_PACKET": 3,
+		"AF_XDP":    4,
 	}
 )
 
@@ -236,6 +269,156 @@ func (PuntRequest_InterconnectType) EnumDescriptor() ([]byte, []int) {
 	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 1}
 }
 
+type PuntRequest_Span_Direction int32
+
+const (
+	// Copy traffic both arriving and leaving via the interface.
+	PuntRequest_Span_BOTH PuntRequest_Span_Direction = 0
+	// Copy only traffic arriving via the interface.
+	PuntRequest_Span_RX PuntRequest_Span_Direction = 1
+	// Copy only traffic leaving via the interface.
+	PuntRequest_Span_TX PuntRequest_Span_Direction = 2
+)
+
+// Enum value maps for PuntRequest_Span_Direction.
+var (
+	PuntRequest_Span_Direction_name = map[int32]string{
+		0: "BOTH",
+		1: "RX",
+		2: "TX",
+	}
+	PuntRequest_Span_Direction_value = map[string]int32{
+		"BOTH": 0,
+		"RX":   1,
+		"TX":   2,
+	}
+)
+
+func (x PuntRequest_Span_Direction) Enum() *PuntRequest_Span_Direction {
+	p := new(PuntRequest_Span_Direction)
+	*p = x
+	return p
+}
+
+func (x PuntRequest_Span_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PuntRequest_Span_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_puntmgr_puntmgr_proto_enumTypes[3].Descriptor()
+}
+
+func (PuntRequest_Span_Direction) Type() protoreflect.EnumType {
+	return &file_puntmgr_puntmgr_proto_enumTypes[3]
+}
+
+func (x PuntRequest_Span_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PuntRequest_Span_Direction.Descriptor instead.
+func (PuntRequest_Span_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 2, 0}
+}
+
+type PuntRequest_RemoteSpan_TunnelType int32
+
+const (
+	// ERSPAN (type II) tunnel.
+	PuntRequest_RemoteSpan_ERSPAN PuntRequest_RemoteSpan_TunnelType = 0
+	// GRE tunnel with transparent ethernet bridging (copied frames are encapsulated including L2 header).
+	PuntRequest_RemoteSpan_GRE_TEB PuntRequest_RemoteSpan_TunnelType = 1
+)
+
+// Enum value maps for PuntRequest_RemoteSpan_TunnelType.
+var (
+	PuntRequest_RemoteSpan_TunnelType_name = map[int32]string{
+		0: "ERSPAN",
+		1: "GRE_TEB",
+	}
+	PuntRequest_RemoteSpan_TunnelType_value = map[string]int32{
+		"ERSPAN":  0,
+		"GRE_TEB": 1,
+	}
+)
+
+func (x PuntRequest_RemoteSpan_TunnelType) Enum() *PuntRequest_RemoteSpan_TunnelType {
+	p := new(PuntRequest_RemoteSpan_TunnelType)
+	*p = x
+	return p
+}
+
+func (x PuntRequest_RemoteSpan_TunnelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PuntRequest_RemoteSpan_TunnelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_puntmgr_puntmgr_proto_enumTypes[4].Descriptor()
+}
+
+func (PuntRequest_RemoteSpan_TunnelType) Type() protoreflect.EnumType {
+	return &file_puntmgr_puntmgr_proto_enumTypes[4]
+}
+
+func (x PuntRequest_RemoteSpan_TunnelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PuntRequest_RemoteSpan_TunnelType.Descriptor instead.
+func (PuntRequest_RemoteSpan_TunnelType) EnumDescriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 3, 0}
+}
+
+type PuntRequest_IpRedirect_L3Protocol int32
+
+const (
+	// Redirect both IPv4 and IPv6 packets.
+	PuntRequest_IpRedirect_ALL  PuntRequest_IpRedirect_L3Protocol = 0
+	PuntRequest_IpRedirect_IPV4 PuntRequest_IpRedirect_L3Protocol = 1
+	PuntRequest_IpRedirect_IPV6 PuntRequest_IpRedirect_L3Protocol = 2
+)
+
+// Enum value maps for PuntRequest_IpRedirect_L3Protocol.
+var (
+	PuntRequest_IpRedirect_L3Protocol_name = map[int32]string{
+		0: "ALL",
+		1: "IPV4",
+		2: "IPV6",
+	}
+	PuntRequest_IpRedirect_L3Protocol_value = map[string]int32{
+		"ALL":  0,
+		"IPV4": 1,
+		"IPV6": 2,
+	}
+)
+
+func (x PuntRequest_IpRedirect_L3Protocol) Enum() *PuntRequest_IpRedirect_L3Protocol {
+	p := new(PuntRequest_IpRedirect_L3Protocol)
+	*p = x
+	return p
+}
+
+func (x PuntRequest_IpRedirect_L3Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PuntRequest_IpRedirect_L3Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_puntmgr_puntmgr_proto_enumTypes[5].Descriptor()
+}
+
+func (PuntRequest_IpRedirect_L3Protocol) Type() protoreflect.EnumType {
+	return &file_puntmgr_puntmgr_proto_enumTypes[5]
+}
+
+func (x PuntRequest_IpRedirect_L3Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PuntRequest_IpRedirect_L3Protocol.Descriptor instead.
+func (PuntRequest_IpRedirect_L3Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 4, 0}
+}
+
 type PuntRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -256,6 +439,9 @@ type PuntRequest struct {
 	//	*PuntRequest_PuntToSocket_
 	//	*PuntRequest_DhcpProxy_
 	//	*PuntRequest_Isisx_
+	//	*PuntRequest_RemoteSpan_
+	//	*PuntRequest_IpRedirect_
+	//	*PuntRequest_LinuxCp_
 	Config isPuntRequest_Config `protobuf_oneof:"config"`
 }
 
@@ -375,6 +561,27 @@ func (x *PuntRequest) GetIsisx() *PuntRequest_Isisx {
 	return nil
 }
 
+func (x *PuntRequest) GetRemoteSpan() *PuntRequest_RemoteSpan {
+	if x, ok := x.GetConfig().(*PuntRequest_RemoteSpan_); ok {
+		return x.RemoteSpan
+	}
+	return nil
+}
+
+func (x *PuntRequest) GetIpRedirect() *PuntRequest_IpRedirect {
+	if x, ok := x.GetConfig().(*PuntRequest_IpRedirect_); ok {
+		return x.IpRedirect
+	}
+	return nil
+}
+
+func (x *PuntRequest) GetLinuxCp() *PuntRequest_LinuxCp {
+	if x, ok := x.GetConfig().(*PuntRequest_LinuxCp_); ok {
+		return x.LinuxCp
+	}
+	return nil
+}
+
 type isPuntRequest_Config interface {
 	isPuntRequest_Config()
 }
@@ -407,6 +614,18 @@ type PuntRequest_Isisx_ struct {
 	Isisx *PuntRequest_Isisx `protobuf:"bytes,16,opt,name=isisx,proto3,oneof"`
 }
 
+type PuntRequest_RemoteSpan_ struct {
+	RemoteSpan *PuntRequest_RemoteSpan `protobuf:"bytes,17,opt,name=remoteSpan,proto3,oneof"`
+}
+
+type PuntRequest_IpRedirect_ struct {
+	IpRedirect *PuntRequest_IpRedirect `protobuf:"bytes,18,opt,name=ipRedirect,proto3,oneof"`
+}
+
+type PuntRequest_LinuxCp_ struct {
+	LinuxCp *PuntRequest_LinuxCp `protobuf:"bytes,19,opt,name=linuxCp,proto3,oneof"`
+}
+
 func (*PuntRequest_HairpinXConnect_) isPuntRequest_Config() {}
 
 func (*PuntRequest_Hairpin_) isPuntRequest_Config() {}
@@ -421,6 +640,12 @@ func (*PuntRequest_DhcpProxy_) isPuntRequest_Config() {}
 
 func (*PuntRequest_Isisx_) isPuntRequest_Config() {}
 
+func (*PuntRequest_RemoteSpan_) isPuntRequest_Config() {}
+
+func (*PuntRequest_IpRedirect_) isPuntRequest_Config() {}
+
+func (*PuntRequest_LinuxCp_) isPuntRequest_Config() {}
+
 // A list of punt requests.
 type PuntRequests struct {
 	state         protoimpl.MessageState
@@ -600,6 +825,8 @@ type UpdatePuntStateReq struct {
 
 	Metadata *PuntMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	State    PuntState     `protobuf:"varint,2,opt,name=state,proto3,enum=puntmgr.PuntState" json:"state,omitempty"`
+	// Error message for the FAILED state.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (x *UpdatePuntStateReq) Reset() {
@@ -648,6 +875,13 @@ func (x *UpdatePuntStateReq) GetState() PuntState {
 	return PuntState_UNKNOWN
 }
 
+func (x *UpdatePuntStateReq) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // UpdatePuntStateResp is empty. UpdatePuntState returns only SUCCESS/FAILURE.
 type UpdatePuntStateResp struct {
 	state         protoimpl.MessageState
@@ -687,18 +921,23 @@ func (*UpdatePuntStateResp) Descriptor() ([]byte, []int) {
 	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{5}
 }
 
-// Type-specific configuration to use for the punt.
-type PuntRequest_HairpinXConnect struct {
+// Usage of a CIDR pool from which subnets are allocated for VPP<->CNF interconnects.
+type SubnetPoolUsage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VppInterface1 string `protobuf:"bytes,1,opt,name=vpp_interface1,json=vppInterface1,proto3" json:"vpp_interface1,omitempty"`
-	VppInterface2 string `protobuf:"bytes,2,opt,name=vpp_interface2,json=vppInterface2,proto3" json:"vpp_interface2,omitempty"`
+	Cidr string `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	// Number of subnets that can be allocated from the pool.
+	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Number of subnets allocated for existing interconnects.
+	Allocated uint32 `protobuf:"varint,3,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	// Number of subnets reserved for punts restored from a checkpoint but not yet re-added.
+	Reserved uint32 `protobuf:"varint,4,opt,name=reserved,proto3" json:"reserved,omitempty"`
 }
 
-func (x *PuntRequest_HairpinXConnect) Reset() {
-	*x = PuntRequest_HairpinXConnect{}
+func (x *SubnetPoolUsage) Reset() {
+	*x = SubnetPoolUsage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_puntmgr_puntmgr_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -706,13 +945,13 @@ func (x *PuntRequest_HairpinXConnect) Reset() {
 	}
 }
 
-func (x *PuntRequest_HairpinXConnect) String() string {
+func (x *SubnetPoolUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PuntRequest_HairpinXConnect) ProtoMessage() {}
+func (*SubnetPoolUsage) ProtoMessage() {}
 
-func (x *PuntRequest_HairpinXConnect) ProtoReflect() protoreflect.Message {
+func (x *SubnetPoolUsage) ProtoReflect() protoreflect.Message {
 	mi := &file_puntmgr_puntmgr_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -724,38 +963,48 @@ func (x *PuntRequest_HairpinXConnect) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PuntRequest_HairpinXConnect.ProtoReflect.Descriptor instead.
-func (*PuntRequest_HairpinXConnect) Descriptor() ([]byte, []int) {
-	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use SubnetPoolUsage.ProtoReflect.Descriptor instead.
+func (*SubnetPoolUsage) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PuntRequest_HairpinXConnect) GetVppInterface1() string {
+func (x *SubnetPoolUsage) GetCidr() string {
 	if x != nil {
-		return x.VppInterface1
+		return x.Cidr
 	}
 	return ""
 }
 
-func (x *PuntRequest_HairpinXConnect) GetVppInterface2() string {
+func (x *SubnetPoolUsage) GetTotal() uint32 {
 	if x != nil {
-		return x.VppInterface2
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type PuntRequest_Hairpin struct {
+func (x *SubnetPoolUsage) GetAllocated() uint32 {
+	if x != nil {
+		return x.Allocated
+	}
+	return 0
+}
+
+func (x *SubnetPoolUsage) GetReserved() uint32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+// GetSubnetUsageReq is empty. GetSubnetUsage returns usage of all CIDR pools.
+type GetSubnetUsageReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Existing VPP interface that will have its traffic hairpinned over CNF/Linux.
-	VppInterface string `protobuf:"bytes,1,opt,name=vpp_interface,json=vppInterface,proto3" json:"vpp_interface,omitempty"`
-	// Newly created TAP/memif interface adding "hairpin" feature to an existing VPP interface.
-	HairpinInterface *PuntRequest_Hairpin_Interface `protobuf:"bytes,2,opt,name=hairpin_interface,json=hairpinInterface,proto3" json:"hairpin_interface,omitempty"`
 }
 
-func (x *PuntRequest_Hairpin) Reset() {
-	*x = PuntRequest_Hairpin{}
+func (x *GetSubnetUsageReq) Reset() {
+	*x = GetSubnetUsageReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_puntmgr_puntmgr_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -763,13 +1012,13 @@ func (x *PuntRequest_Hairpin) Reset() {
 	}
 }
 
-func (x *PuntRequest_Hairpin) String() string {
+func (x *GetSubnetUsageReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PuntRequest_Hairpin) ProtoMessage() {}
+func (*GetSubnetUsageReq) ProtoMessage() {}
 
-func (x *PuntRequest_Hairpin) ProtoReflect() protoreflect.Message {
+func (x *GetSubnetUsageReq) ProtoReflect() protoreflect.Message {
 	mi := &file_puntmgr_puntmgr_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -781,35 +1030,22 @@ func (x *PuntRequest_Hairpin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PuntRequest_Hairpin.ProtoReflect.Descriptor instead.
-func (*PuntRequest_Hairpin) Descriptor() ([]byte, []int) {
-	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 1}
-}
-
-func (x *PuntRequest_Hairpin) GetVppInterface() string {
-	if x != nil {
-		return x.VppInterface
-	}
-	return ""
-}
-
-func (x *PuntRequest_Hairpin) GetHairpinInterface() *PuntRequest_Hairpin_Interface {
-	if x != nil {
-		return x.HairpinInterface
-	}
-	return nil
+// Deprecated: Use GetSubnetUsageReq.ProtoReflect.Descriptor instead.
+func (*GetSubnetUsageReq) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{7}
 }
 
-type PuntRequest_Span struct {
+// GetSubnetUsageResp encapsulates output of GetSubnetUsage gRPC.
+type GetSubnetUsageResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VppInterface string `protobuf:"bytes,1,opt,name=vpp_interface,json=vppInterface,proto3" json:"vpp_interface,omitempty"`
+	Pools []*SubnetPoolUsage `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 }
 
-func (x *PuntRequest_Span) Reset() {
-	*x = PuntRequest_Span{}
+func (x *GetSubnetUsageResp) Reset() {
+	*x = GetSubnetUsageResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_puntmgr_puntmgr_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -817,13 +1053,13 @@ func (x *PuntRequest_Span) Reset() {
 	}
 }
 
-func (x *PuntRequest_Span) String() string {
+func (x *GetSubnetUsageResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PuntRequest_Span) ProtoMessage() {}
+func (*GetSubnetUsageResp) ProtoMessage() {}
 
-func (x *PuntRequest_Span) ProtoReflect() protoreflect.Message {
+func (x *GetSubnetUsageResp) ProtoReflect() protoreflect.Message {
 	mi := &file_puntmgr_puntmgr_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -835,39 +1071,37 @@ func (x *PuntRequest_Span) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PuntRequest_Span.ProtoReflect.Descriptor instead.
-func (*PuntRequest_Span) Descriptor() ([]byte, []int) {
-	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 2}
+// Deprecated: Use GetSubnetUsageResp.ProtoReflect.Descriptor instead.
+func (*GetSubnetUsageResp) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PuntRequest_Span) GetVppInterface() string {
+func (x *GetSubnetUsageResp) GetPools() []*SubnetPoolUsage {
 	if x != nil {
-		return x.VppInterface
+		return x.Pools
 	}
-	return ""
+	return nil
 }
 
-type PuntRequest_Abx struct {
+// PuntInfo describes a punt known to the Punt Manager.
+type PuntInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VppInterface string `protobuf:"bytes,1,opt,name=vpp_interface,json=vppInterface,proto3" json:"vpp_interface,omitempty"`
-	// VPP interface is expected to be inside this VRF.
-	// Punt will not be configured until this dependency is satisfied.
-	Vrf uint32 `protobuf:"varint,2,opt,name=vrf,proto3" json:"vrf,omitempty"`
-	// Enable if VRF is not used on the CNF side.
-	WithoutCnfVrf bool `protobuf:"varint,3,opt,name=without_cnf_vrf,json=withoutCnfVrf,proto3" json:"without_cnf_vrf,omitempty"`
-	// DestinationNetwork and SourceNetwork are allowed to contain special constants:
-	//   - "any": match any source/destination IPv4/IPv6 address
-	//   - "" (empty string): same as "any"
-	//   - "local": match traffic destined to one of the IP addresses assigned to vpp_interface
-	IngressAclRules []*acl.ACL_Rule_IpRule `protobuf:"bytes,4,rep,name=ingress_acl_rules,json=ingressAclRules,proto3" json:"ingress_acl_rules,omitempty"`
-	EgressAclRules  []*acl.ACL_Rule_IpRule `protobuf:"bytes,5,rep,name=egress_acl_rules,json=egressAclRules,proto3" json:"egress_acl_rules,omitempty"`
+	Metadata *PuntMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	State    PuntState     `protobuf:"varint,2,opt,name=state,proto3,enum=puntmgr.PuntState" json:"state,omitempty"`
+	// Request for which the punt was created.
+	// Only known to StoneWork and standalone CNF (not to SW-Module).
+	Request *PuntRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	// Interconnects of the punt that are shared with other punts.
+	SharedInterconnects []*PuntInfo_SharedInterconnect `protobuf:"bytes,4,rep,name=shared_interconnects,json=sharedInterconnects,proto3" json:"shared_interconnects,omitempty"`
+	// Error message for the FAILED state.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *PuntRequest_Abx) Reset() {
-	*x = PuntRequest_Abx{}
+func (x *PuntInfo) Reset() {
+	*x = PuntInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_puntmgr_puntmgr_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -875,13 +1109,13 @@ func (x *PuntRequest_Abx) Reset() {
 	}
 }
 
-func (x *PuntRequest_Abx) String() string {
+func (x *PuntInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PuntRequest_Abx) ProtoMessage() {}
+func (*PuntInfo) ProtoMessage() {}
 
-func (x *PuntRequest_Abx) ProtoReflect() protoreflect.Message {
+func (x *PuntInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_puntmgr_puntmgr_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -893,60 +1127,60 @@ func (x *PuntRequest_Abx) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PuntRequest_Abx.ProtoReflect.Descriptor instead.
-func (*PuntRequest_Abx) Descriptor() ([]byte, []int) {
-	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 3}
+// Deprecated: Use PuntInfo.ProtoReflect.Descriptor instead.
+func (*PuntInfo) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PuntRequest_Abx) GetVppInterface() string {
+func (x *PuntInfo) GetMetadata() *PuntMetadata {
 	if x != nil {
-		return x.VppInterface
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *PuntRequest_Abx) GetVrf() uint32 {
+func (x *PuntInfo) GetState() PuntState {
 	if x != nil {
-		return x.Vrf
+		return x.State
 	}
-	return 0
+	return PuntState_UNKNOWN
 }
 
-func (x *PuntRequest_Abx) GetWithoutCnfVrf() bool {
+func (x *PuntInfo) GetRequest() *PuntRequest {
 	if x != nil {
-		return x.WithoutCnfVrf
+		return x.Request
 	}
-	return false
+	return nil
 }
 
-func (x *PuntRequest_Abx) GetIngressAclRules() []*acl.ACL_Rule_IpRule {
+func (x *PuntInfo) GetSharedInterconnects() []*PuntInfo_SharedInterconnect {
 	if x != nil {
-		return x.IngressAclRules
+		return x.SharedInterconnects
 	}
 	return nil
 }
 
-func (x *PuntRequest_Abx) GetEgressAclRules() []*acl.ACL_Rule_IpRule {
+func (x *PuntInfo) GetError() string {
 	if x != nil {
-		return x.EgressAclRules
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type PuntRequest_PuntToSocket struct {
+// ListPuntsReq encapsulates input arguments to ListPunts gRPC.
+type ListPuntsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Config:
-	//
-	//	*PuntRequest_PuntToSocket_ToHost
-	//	*PuntRequest_PuntToSocket_Exception
-	Config isPuntRequest_PuntToSocket_Config `protobuf_oneof:"config"`
+	// Only list punts created for the CNF with this microservice label (all CNFs if empty).
+	CnfMsLabel string `protobuf:"bytes,1,opt,name=cnf_ms_label,json=cnfMsLabel,proto3" json:"cnf_ms_label,omitempty"`
+	// Only list punts created for the configuration item with this key (all keys if empty).
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *PuntRequest_PuntToSocket) Reset() {
-	*x = PuntRequest_PuntToSocket{}
+func (x *ListPuntsReq) Reset() {
+	*x = ListPuntsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_puntmgr_puntmgr_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -954,13 +1188,13 @@ func (x *PuntRequest_PuntToSocket) Reset() {
 	}
 }
 
-func (x *PuntRequest_PuntToSocket) String() string {
+func (x *ListPuntsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PuntRequest_PuntToSocket) ProtoMessage() {}
+func (*ListPuntsReq) ProtoMessage() {}
 
-func (x *PuntRequest_PuntToSocket) ProtoReflect() protoreflect.Message {
+func (x *ListPuntsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_puntmgr_puntmgr_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -972,37 +1206,787 @@ func (x *PuntRequest_PuntToSocket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PuntRequest_PuntToSocket.ProtoReflect.Descriptor instead.
-func (*PuntRequest_PuntToSocket) Descriptor() ([]byte, []int) {
-	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 4}
+// Deprecated: Use ListPuntsReq.ProtoReflect.Descriptor instead.
+func (*ListPuntsReq) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{10}
 }
 
-func (m *PuntRequest_PuntToSocket) GetConfig() isPuntRequest_PuntToSocket_Config {
-	if m != nil {
-		return m.Config
+func (x *ListPuntsReq) GetCnfMsLabel() string {
+	if x != nil {
+		return x.CnfMsLabel
 	}
-	return nil
+	return ""
 }
 
-func (x *PuntRequest_PuntToSocket) GetToHost() *punt.ToHost {
-	if x, ok := x.GetConfig().(*PuntRequest_PuntToSocket_ToHost); ok {
-		return x.ToHost
+func (x *ListPuntsReq) GetKey() string {
+	if x != nil {
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *PuntRequest_PuntToSocket) GetException() *punt.Exception {
-	if x, ok := x.GetConfig().(*PuntRequest_PuntToSocket_Exception); ok {
-		return x.Exception
-	}
-	return nil
-}
+// ListPuntsResp encapsulates output of ListPunts gRPC.
+type ListPuntsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type isPuntRequest_PuntToSocket_Config interface {
-	isPuntRequest_PuntToSocket_Config()
+	Punts []*PuntInfo `protobuf:"bytes,1,rep,name=punts,proto3" json:"punts,omitempty"`
 }
 
-type PuntRequest_PuntToSocket_ToHost struct {
+func (x *ListPuntsResp) Reset() {
+	*x = ListPuntsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPuntsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPuntsResp) ProtoMessage() {}
+
+func (x *ListPuntsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPuntsResp.ProtoReflect.Descriptor instead.
+func (*ListPuntsResp) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPuntsResp) GetPunts() []*PuntInfo {
+	if x != nil {
+		return x.Punts
+	}
+	return nil
+}
+
+// WatchPuntsReq encapsulates input arguments to WatchPunts gRPC.
+type WatchPuntsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only watch punts created for the CNF with this microservice label (all CNFs if empty).
+	CnfMsLabel string `protobuf:"bytes,1,opt,name=cnf_ms_label,json=cnfMsLabel,proto3" json:"cnf_ms_label,omitempty"`
+	// Only watch punts created for the configuration item with this key (all keys if empty).
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Enable to receive the current state of all (selected) punts before any state transition.
+	WithCurrentState bool `protobuf:"varint,3,opt,name=with_current_state,json=withCurrentState,proto3" json:"with_current_state,omitempty"`
+}
+
+func (x *WatchPuntsReq) Reset() {
+	*x = WatchPuntsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPuntsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPuntsReq) ProtoMessage() {}
+
+func (x *WatchPuntsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPuntsReq.ProtoReflect.Descriptor instead.
+func (*WatchPuntsReq) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchPuntsReq) GetCnfMsLabel() string {
+	if x != nil {
+		return x.CnfMsLabel
+	}
+	return ""
+}
+
+func (x *WatchPuntsReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *WatchPuntsReq) GetWithCurrentState() bool {
+	if x != nil {
+		return x.WithCurrentState
+	}
+	return false
+}
+
+// PuntEvent is sent by WatchPunts gRPC for every state transition of a watched punt.
+type PuntEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Punt with the new state (DELETED for removed punt).
+	Punt *PuntInfo `protobuf:"bytes,1,opt,name=punt,proto3" json:"punt,omitempty"`
+	// State of the punt before the transition (UNKNOWN for new punt or for the current state of a punt).
+	PrevState PuntState `protobuf:"varint,2,opt,name=prev_state,json=prevState,proto3,enum=puntmgr.PuntState" json:"prev_state,omitempty"`
+}
+
+func (x *PuntEvent) Reset() {
+	*x = PuntEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuntEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuntEvent) ProtoMessage() {}
+
+func (x *PuntEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuntEvent.ProtoReflect.Descriptor instead.
+func (*PuntEvent) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PuntEvent) GetPunt() *PuntInfo {
+	if x != nil {
+		return x.Punt
+	}
+	return nil
+}
+
+func (x *PuntEvent) GetPrevState() PuntState {
+	if x != nil {
+		return x.PrevState
+	}
+	return PuntState_UNKNOWN
+}
+
+// Type-specific configuration to use for the punt.
+type PuntRequest_HairpinXConnect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VppInterface1 string `protobuf:"bytes,1,opt,name=vpp_interface1,json=vppInterface1,proto3" json:"vpp_interface1,omitempty"`
+	VppInterface2 string `protobuf:"bytes,2,opt,name=vpp_interface2,json=vppInterface2,proto3" json:"vpp_interface2,omitempty"`
+}
+
+func (x *PuntRequest_HairpinXConnect) Reset() {
+	*x = PuntRequest_HairpinXConnect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuntRequest_HairpinXConnect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuntRequest_HairpinXConnect) ProtoMessage() {}
+
+func (x *PuntRequest_HairpinXConnect) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuntRequest_HairpinXConnect.ProtoReflect.Descriptor instead.
+func (*PuntRequest_HairpinXConnect) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *PuntRequest_HairpinXConnect) GetVppInterface1() string {
+	if x != nil {
+		return x.VppInterface1
+	}
+	return ""
+}
+
+func (x *PuntRequest_HairpinXConnect) GetVppInterface2() string {
+	if x != nil {
+		return x.VppInterface2
+	}
+	return ""
+}
+
+type PuntRequest_Hairpin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Existing VPP interface that will have its traffic hairpinned over CNF/Linux.
+	VppInterface string `protobuf:"bytes,1,opt,name=vpp_interface,json=vppInterface,proto3" json:"vpp_interface,omitempty"`
+	// Newly created TAP/memif interface adding "hairpin" feature to an existing VPP interface.
+	HairpinInterface *PuntRequest_Hairpin_Interface `protobuf:"bytes,2,opt,name=hairpin_interface,json=hairpinInterface,proto3" json:"hairpin_interface,omitempty"`
+}
+
+func (x *PuntRequest_Hairpin) Reset() {
+	*x = PuntRequest_Hairpin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuntRequest_Hairpin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuntRequest_Hairpin) ProtoMessage() {}
+
+func (x *PuntRequest_Hairpin) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuntRequest_Hairpin.ProtoReflect.Descriptor instead.
+func (*PuntRequest_Hairpin) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *PuntRequest_Hairpin) GetVppInterface() string {
+	if x != nil {
+		return x.VppInterface
+	}
+	return ""
+}
+
+func (x *PuntRequest_Hairpin) GetHairpinInterface() *PuntRequest_Hairpin_Interface {
+	if x != nil {
+		return x.HairpinInterface
+	}
+	return nil
+}
+
+type PuntRequest_Span struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VppInterface string                     `protobuf:"bytes,1,opt,name=vpp_interface,json=vppInterface,proto3" json:"vpp_interface,omitempty"`
+	Direction    PuntRequest_Span_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=puntmgr.PuntRequest_Span_Direction" json:"direction,omitempty"`
+	// Copy traffic at the L2 level (interface is expected to be in L2 mode, i.e. inside a bridge domain
+	// or x-connect) instead of the device level.
+	IsL2 bool `protobuf:"varint,3,opt,name=is_l2,json=isL2,proto3" json:"is_l2,omitempty"`
+	// Copy only traffic matching at least one of the rules (all traffic if empty).
+	// Filters are supported only for L2 SPAN (is_l2 = true).
+	// DestinationNetwork and SourceNetwork are allowed to contain the special constant "any"
+	// (or empty string) matching any source/destination IPv4/IPv6 address.
+	Filters []*acl.ACL_Rule_IpRule `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *PuntRequest_Span) Reset() {
+	*x = PuntRequest_Span{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuntRequest_Span) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuntRequest_Span) ProtoMessage() {}
+
+func (x *PuntRequest_Span) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuntRequest_Span.ProtoReflect.Descriptor instead.
+func (*PuntRequest_Span) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *PuntRequest_Span) GetVppInterface() string {
+	if x != nil {
+		return x.VppInterface
+	}
+	return ""
+}
+
+func (x *PuntRequest_Span) GetDirection() PuntRequest_Span_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return PuntRequest_Span_BOTH
+}
+
+func (x *PuntRequest_Span) GetIsL2() bool {
+	if x != nil {
+		return x.IsL2
+	}
+	return false
+}
+
+func (x *PuntRequest_Span) GetFilters() []*acl.ACL_Rule_IpRule {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type PuntRequest_RemoteSpan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Traffic to copy.
+	Span       *PuntRequest_Span                 `protobuf:"bytes,1,opt,name=span,proto3" json:"span,omitempty"`
+	TunnelType PuntRequest_RemoteSpan_TunnelType `protobuf:"varint,2,opt,name=tunnel_type,json=tunnelType,proto3,enum=puntmgr.PuntRequest_RemoteSpan_TunnelType" json:"tunnel_type,omitempty"`
+	// Source IP address of the tunnel, expected to be assigned to a VPP interface.
+	SrcAddress string `protobuf:"bytes,3,opt,name=src_address,json=srcAddress,proto3" json:"src_address,omitempty"`
+	// Destination IP address of the tunnel (remote traffic analyzer).
+	DstAddress string `protobuf:"bytes,4,opt,name=dst_address,json=dstAddress,proto3" json:"dst_address,omitempty"`
+	// VRF used to route tunneled packets.
+	OuterVrf uint32 `protobuf:"varint,5,opt,name=outer_vrf,json=outerVrf,proto3" json:"outer_vrf,omitempty"`
+	// ERSPAN session ID (not used with GRE_TEB).
+	SessionId uint32 `protobuf:"varint,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *PuntRequest_RemoteSpan) Reset() {
+	*x = PuntRequest_RemoteSpan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuntRequest_RemoteSpan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuntRequest_RemoteSpan) ProtoMessage() {}
+
+func (x *PuntRequest_RemoteSpan) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuntRequest_RemoteSpan.ProtoReflect.Descriptor instead.
+func (*PuntRequest_RemoteSpan) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *PuntRequest_RemoteSpan) GetSpan() *PuntRequest_Span {
+	if x != nil {
+		return x.Span
+	}
+	return nil
+}
+
+func (x *PuntRequest_RemoteSpan) GetTunnelType() PuntRequest_RemoteSpan_TunnelType {
+	if x != nil {
+		return x.TunnelType
+	}
+	return PuntRequest_RemoteSpan_ERSPAN
+}
+
+func (x *PuntRequest_RemoteSpan) GetSrcAddress() string {
+	if x != nil {
+		return x.SrcAddress
+	}
+	return ""
+}
+
+func (x *PuntRequest_RemoteSpan) GetDstAddress() string {
+	if x != nil {
+		return x.DstAddress
+	}
+	return ""
+}
+
+func (x *PuntRequest_RemoteSpan) GetOuterVrf() uint32 {
+	if x != nil {
+		return x.OuterVrf
+	}
+	return 0
+}
+
+func (x *PuntRequest_RemoteSpan) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type PuntRequest_IpRedirect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Redirect only packets punted from this VPP interface (from any interface if empty).
+	VppInterface string                            `protobuf:"bytes,1,opt,name=vpp_interface,json=vppInterface,proto3" json:"vpp_interface,omitempty"`
+	L3Protocol   PuntRequest_IpRedirect_L3Protocol `protobuf:"varint,2,opt,name=l3_protocol,json=l3Protocol,proto3,enum=puntmgr.PuntRequest_IpRedirect_L3Protocol" json:"l3_protocol,omitempty"`
+	// VPP side of the interconnect is put inside this VRF.
+	// Punt will not be configured until this dependency is satisfied.
+	Vrf uint32 `protobuf:"varint,3,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	// Enable if VRF is not used on the CNF side.
+	WithoutCnfVrf bool `protobuf:"varint,4,opt,name=without_cnf_vrf,json=withoutCnfVrf,proto3" json:"without_cnf_vrf,omitempty"`
+}
+
+func (x *PuntRequest_IpRedirect) Reset() {
+	*x = PuntRequest_IpRedirect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuntRequest_IpRedirect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuntRequest_IpRedirect) ProtoMessage() {}
+
+func (x *PuntRequest_IpRedirect) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuntRequest_IpRedirect.ProtoReflect.Descriptor instead.
+func (*PuntRequest_IpRedirect) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *PuntRequest_IpRedirect) GetVppInterface() string {
+	if x != nil {
+		return x.VppInterface
+	}
+	return ""
+}
+
+func (x *PuntRequest_IpRedirect) GetL3Protocol() PuntRequest_IpRedirect_L3Protocol {
+	if x != nil {
+		return x.L3Protocol
+	}
+	return PuntRequest_IpRedirect_ALL
+}
+
+func (x *PuntRequest_IpRedirect) GetVrf() uint32 {
+	if x != nil {
+		return x.Vrf
+	}
+	return 0
+}
+
+func (x *PuntRequest_IpRedirect) GetWithoutCnfVrf() bool {
+	if x != nil {
+		return x.WithoutCnfVrf
+	}
+	return false
+}
+
+type PuntRequest_Abx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VppInterface string `protobuf:"bytes,1,opt,name=vpp_interface,json=vppInterface,proto3" json:"vpp_interface,omitempty"`
+	// VPP interface is expected to be inside this VRF.
+	// Punt will not be configured until this dependency is satisfied.
+	Vrf uint32 `protobuf:"varint,2,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	// Enable if VRF is not used on the CNF side.
+	WithoutCnfVrf bool `protobuf:"varint,3,opt,name=without_cnf_vrf,json=withoutCnfVrf,proto3" json:"without_cnf_vrf,omitempty"`
+	// DestinationNetwork and SourceNetwork are allowed to contain special constants:
+	//   - "any": match any source/destination IPv4/IPv6 address
+	//   - "" (empty string): same as "any"
+	//   - "local": match traffic destined to one of the IP addresses assigned to vpp_interface
+	IngressAclRules []*acl.ACL_Rule_IpRule `protobuf:"bytes,4,rep,name=ingress_acl_rules,json=ingressAclRules,proto3" json:"ingress_acl_rules,omitempty"`
+	EgressAclRules  []*acl.ACL_Rule_IpRule `protobuf:"bytes,5,rep,name=egress_acl_rules,json=egressAclRules,proto3" json:"egress_acl_rules,omitempty"`
+}
+
+func (x *PuntRequest_Abx) Reset() {
+	*x = PuntRequest_Abx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuntRequest_Abx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuntRequest_Abx) ProtoMessage() {}
+
+func (x *PuntRequest_Abx) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuntRequest_Abx.ProtoReflect.Descriptor instead.
+func (*PuntRequest_Abx) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *PuntRequest_Abx) GetVppInterface() string {
+	if x != nil {
+		return x.VppInterface
+	}
+	return ""
+}
+
+func (x *PuntRequest_Abx) GetVrf() uint32 {
+	if x != nil {
+		return x.Vrf
+	}
+	return 0
+}
+
+func (x *PuntRequest_Abx) GetWithoutCnfVrf() bool {
+	if x != nil {
+		return x.WithoutCnfVrf
+	}
+	return false
+}
+
+func (x *PuntRequest_Abx) GetIngressAclRules() []*acl.ACL_Rule_IpRule {
+	if x != nil {
+		return x.IngressAclRules
+	}
+	return nil
+}
+
+func (x *PuntRequest_Abx) GetEgressAclRules() []*acl.ACL_Rule_IpRule {
+	if x != nil {
+		return x.EgressAclRules
+	}
+	return nil
+}
+
+type PuntRequest_LinuxCp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VppInterface string `protobuf:"bytes,1,opt,name=vpp_interface,json=vppInterface,proto3" json:"vpp_interface,omitempty"`
+	// VPP interface is expected to be inside this VRF.
+	// Punt will not be configured until this dependency is satisfied.
+	Vrf uint32 `protobuf:"varint,2,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	// Enable if VRF is not used on the CNF side.
+	WithoutCnfVrf bool `protobuf:"varint,3,opt,name=without_cnf_vrf,json=withoutCnfVrf,proto3" json:"without_cnf_vrf,omitempty"`
+	// Name of the host interface created in the CNF (max 15 characters).
+	// If empty then the name will be generated by PuntManager.
+	HostInterface string `protobuf:"bytes,4,opt,name=host_interface,json=hostInterface,proto3" json:"host_interface,omitempty"`
+}
+
+func (x *PuntRequest_LinuxCp) Reset() {
+	*x = PuntRequest_LinuxCp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuntRequest_LinuxCp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuntRequest_LinuxCp) ProtoMessage() {}
+
+func (x *PuntRequest_LinuxCp) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuntRequest_LinuxCp.ProtoReflect.Descriptor instead.
+func (*PuntRequest_LinuxCp) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *PuntRequest_LinuxCp) GetVppInterface() string {
+	if x != nil {
+		return x.VppInterface
+	}
+	return ""
+}
+
+func (x *PuntRequest_LinuxCp) GetVrf() uint32 {
+	if x != nil {
+		return x.Vrf
+	}
+	return 0
+}
+
+func (x *PuntRequest_LinuxCp) GetWithoutCnfVrf() bool {
+	if x != nil {
+		return x.WithoutCnfVrf
+	}
+	return false
+}
+
+func (x *PuntRequest_LinuxCp) GetHostInterface() string {
+	if x != nil {
+		return x.HostInterface
+	}
+	return ""
+}
+
+type PuntRequest_PuntToSocket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Config:
+	//
+	//	*PuntRequest_PuntToSocket_ToHost
+	//	*PuntRequest_PuntToSocket_Exception
+	Config isPuntRequest_PuntToSocket_Config `protobuf_oneof:"config"`
+}
+
+func (x *PuntRequest_PuntToSocket) Reset() {
+	*x = PuntRequest_PuntToSocket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuntRequest_PuntToSocket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuntRequest_PuntToSocket) ProtoMessage() {}
+
+func (x *PuntRequest_PuntToSocket) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuntRequest_PuntToSocket.ProtoReflect.Descriptor instead.
+func (*PuntRequest_PuntToSocket) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 7}
+}
+
+func (m *PuntRequest_PuntToSocket) GetConfig() isPuntRequest_PuntToSocket_Config {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (x *PuntRequest_PuntToSocket) GetToHost() *punt.ToHost {
+	if x, ok := x.GetConfig().(*PuntRequest_PuntToSocket_ToHost); ok {
+		return x.ToHost
+	}
+	return nil
+}
+
+func (x *PuntRequest_PuntToSocket) GetException() *punt.Exception {
+	if x, ok := x.GetConfig().(*PuntRequest_PuntToSocket_Exception); ok {
+		return x.Exception
+	}
+	return nil
+}
+
+type isPuntRequest_PuntToSocket_Config interface {
+	isPuntRequest_PuntToSocket_Config()
+}
+
+type PuntRequest_PuntToSocket_ToHost struct {
 	ToHost *punt.ToHost `protobuf:"bytes,1,opt,name=toHost,proto3,oneof"`
 }
 
@@ -1027,7 +2011,7 @@ type PuntRequest_DhcpProxy struct {
 func (x *PuntRequest_DhcpProxy) Reset() {
 	*x = PuntRequest_DhcpProxy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_puntmgr_puntmgr_proto_msgTypes[11]
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1040,7 +2024,7 @@ func (x *PuntRequest_DhcpProxy) String() string {
 func (*PuntRequest_DhcpProxy) ProtoMessage() {}
 
 func (x *PuntRequest_DhcpProxy) ProtoReflect() protoreflect.Message {
-	mi := &file_puntmgr_puntmgr_proto_msgTypes[11]
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1053,7 +2037,7 @@ func (x *PuntRequest_DhcpProxy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PuntRequest_DhcpProxy.ProtoReflect.Descriptor instead.
 func (*PuntRequest_DhcpProxy) Descriptor() ([]byte, []int) {
-	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 5}
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 8}
 }
 
 func (x *PuntRequest_DhcpProxy) GetVrf() uint32 {
@@ -1088,7 +2072,7 @@ type PuntRequest_Isisx struct {
 func (x *PuntRequest_Isisx) Reset() {
 	*x = PuntRequest_Isisx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_puntmgr_puntmgr_proto_msgTypes[12]
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1101,7 +2085,7 @@ func (x *PuntRequest_Isisx) String() string {
 func (*PuntRequest_Isisx) ProtoMessage() {}
 
 func (x *PuntRequest_Isisx) ProtoReflect() protoreflect.Message {
-	mi := &file_puntmgr_puntmgr_proto_msgTypes[12]
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,7 +2098,7 @@ func (x *PuntRequest_Isisx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PuntRequest_Isisx.ProtoReflect.Descriptor instead.
 func (*PuntRequest_Isisx) Descriptor() ([]byte, []int) {
-	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 6}
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{0, 9}
 }
 
 func (x *PuntRequest_Isisx) GetVppInterface() string {
@@ -1162,7 +2146,7 @@ type PuntRequest_Hairpin_Interface struct {
 func (x *PuntRequest_Hairpin_Interface) Reset() {
 	*x = PuntRequest_Hairpin_Interface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_puntmgr_puntmgr_proto_msgTypes[13]
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1175,7 +2159,7 @@ func (x *PuntRequest_Hairpin_Interface) String() string {
 func (*PuntRequest_Hairpin_Interface) ProtoMessage() {}
 
 func (x *PuntRequest_Hairpin_Interface) ProtoReflect() protoreflect.Message {
-	mi := &file_puntmgr_puntmgr_proto_msgTypes[13]
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1250,7 +2234,7 @@ type PuntMetadata_Interface struct {
 func (x *PuntMetadata_Interface) Reset() {
 	*x = PuntMetadata_Interface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_puntmgr_puntmgr_proto_msgTypes[14]
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1263,7 +2247,7 @@ func (x *PuntMetadata_Interface) String() string {
 func (*PuntMetadata_Interface) ProtoMessage() {}
 
 func (x *PuntMetadata_Interface) ProtoReflect() protoreflect.Message {
-	mi := &file_puntmgr_puntmgr_proto_msgTypes[14]
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,7 +2315,7 @@ type PuntMetadata_InterconnectID struct {
 func (x *PuntMetadata_InterconnectID) Reset() {
 	*x = PuntMetadata_InterconnectID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_puntmgr_puntmgr_proto_msgTypes[15]
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1344,7 +2328,7 @@ func (x *PuntMetadata_InterconnectID) String() string {
 func (*PuntMetadata_InterconnectID) ProtoMessage() {}
 
 func (x *PuntMetadata_InterconnectID) ProtoReflect() protoreflect.Message {
-	mi := &file_puntmgr_puntmgr_proto_msgTypes[15]
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,6 +2358,86 @@ func (x *PuntMetadata_InterconnectID) GetCnfSelector() string {
 	return ""
 }
 
+// Tunnel from VPP to a remote destination.
+type PuntMetadata_Tunnel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunnelType PuntRequest_RemoteSpan_TunnelType `protobuf:"varint,1,opt,name=tunnel_type,json=tunnelType,proto3,enum=puntmgr.PuntRequest_RemoteSpan_TunnelType" json:"tunnel_type,omitempty"`
+	SrcAddress string                            `protobuf:"bytes,2,opt,name=src_address,json=srcAddress,proto3" json:"src_address,omitempty"`
+	DstAddress string                            `protobuf:"bytes,3,opt,name=dst_address,json=dstAddress,proto3" json:"dst_address,omitempty"`
+	OuterVrf   uint32                            `protobuf:"varint,4,opt,name=outer_vrf,json=outerVrf,proto3" json:"outer_vrf,omitempty"`
+	SessionId  uint32                            `protobuf:"varint,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *PuntMetadata_Tunnel) Reset() {
+	*x = PuntMetadata_Tunnel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuntMetadata_Tunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuntMetadata_Tunnel) ProtoMessage() {}
+
+func (x *PuntMetadata_Tunnel) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuntMetadata_Tunnel.ProtoReflect.Descriptor instead.
+func (*PuntMetadata_Tunnel) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *PuntMetadata_Tunnel) GetTunnelType() PuntRequest_RemoteSpan_TunnelType {
+	if x != nil {
+		return x.TunnelType
+	}
+	return PuntRequest_RemoteSpan_ERSPAN
+}
+
+func (x *PuntMetadata_Tunnel) GetSrcAddress() string {
+	if x != nil {
+		return x.SrcAddress
+	}
+	return ""
+}
+
+func (x *PuntMetadata_Tunnel) GetDstAddress() string {
+	if x != nil {
+		return x.DstAddress
+	}
+	return ""
+}
+
+func (x *PuntMetadata_Tunnel) GetOuterVrf() uint32 {
+	if x != nil {
+		return x.OuterVrf
+	}
+	return 0
+}
+
+func (x *PuntMetadata_Tunnel) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
 // Interface based VPP<->CNF interconnects.
 // Not used with PUNT_TO_SOCKET.
 type PuntMetadata_Interconnect struct {
@@ -1390,12 +2454,15 @@ type PuntMetadata_Interconnect struct {
 	CnfInterface *PuntMetadata_Interface `protobuf:"bytes,3,opt,name=cnf_interface,json=cnfInterface,proto3" json:"cnf_interface,omitempty"`
 	// Enabled if more than one punt is using this interconnect.
 	Shared bool `protobuf:"varint,4,opt,name=shared,proto3" json:"shared,omitempty"`
+	// Endpoints of the tunnel for interconnect to a remote destination (REMOTE_SPAN), nil otherwise.
+	// VPP side is the tunnel interface, CNF side is nil.
+	Tunnel *PuntMetadata_Tunnel `protobuf:"bytes,5,opt,name=tunnel,proto3" json:"tunnel,omitempty"`
 }
 
 func (x *PuntMetadata_Interconnect) Reset() {
 	*x = PuntMetadata_Interconnect{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_puntmgr_puntmgr_proto_msgTypes[16]
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1408,7 +2475,7 @@ func (x *PuntMetadata_Interconnect) String() string {
 func (*PuntMetadata_Interconnect) ProtoMessage() {}
 
 func (x *PuntMetadata_Interconnect) ProtoReflect() protoreflect.Message {
-	mi := &file_puntmgr_puntmgr_proto_msgTypes[16]
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1421,7 +2488,7 @@ func (x *PuntMetadata_Interconnect) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PuntMetadata_Interconnect.ProtoReflect.Descriptor instead.
 func (*PuntMetadata_Interconnect) Descriptor() ([]byte, []int) {
-	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{3, 2}
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{3, 3}
 }
 
 func (x *PuntMetadata_Interconnect) GetId() *PuntMetadata_InterconnectID {
@@ -1452,6 +2519,70 @@ func (x *PuntMetadata_Interconnect) GetShared() bool {
 	return false
 }
 
+func (x *PuntMetadata_Interconnect) GetTunnel() *PuntMetadata_Tunnel {
+	if x != nil {
+		return x.Tunnel
+	}
+	return nil
+}
+
+// Usage of an interconnect shared with other punts.
+type PuntInfo_SharedInterconnect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id *PuntMetadata_InterconnectID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// All punts using the interconnect (including this one).
+	UsedBy []*PuntID `protobuf:"bytes,2,rep,name=used_by,json=usedBy,proto3" json:"used_by,omitempty"`
+}
+
+func (x *PuntInfo_SharedInterconnect) Reset() {
+	*x = PuntInfo_SharedInterconnect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_puntmgr_puntmgr_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuntInfo_SharedInterconnect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuntInfo_SharedInterconnect) ProtoMessage() {}
+
+func (x *PuntInfo_SharedInterconnect) ProtoReflect() protoreflect.Message {
+	mi := &file_puntmgr_puntmgr_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuntInfo_SharedInterconnect.ProtoReflect.Descriptor instead.
+func (*PuntInfo_SharedInterconnect) Descriptor() ([]byte, []int) {
+	return file_puntmgr_puntmgr_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *PuntInfo_SharedInterconnect) GetId() *PuntMetadata_InterconnectID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *PuntInfo_SharedInterconnect) GetUsedBy() []*PuntID {
+	if x != nil {
+		return x.UsedBy
+	}
+	return nil
+}
+
 var File_puntmgr_puntmgr_proto protoreflect.FileDescriptor
 
 var file_puntmgr_puntmgr_proto_rawDesc = []byte{
@@ -1460,7 +2591,7 @@ var file_puntmgr_puntmgr_proto_rawDesc = []byte{
 	0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x61, 0x63, 0x6c,
 	0x2f, 0x61, 0x63, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61,
 	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x2f, 0x70, 0x75, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x0e, 0x0a, 0x0b, 0x50, 0x75, 0x6e, 0x74, 0x52,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x17, 0x0a, 0x0b, 0x50, 0x75, 0x6e, 0x74, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x3a, 0x0a, 0x09,
 	0x70, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
@@ -1500,7 +2631,19 @@ var file_puntmgr_puntmgr_proto_rawDesc = []byte{
 	0x32, 0x0a, 0x05, 0x69, 0x73, 0x69, 0x73, 0x78, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x73, 0x69, 0x73, 0x78, 0x48, 0x00, 0x52, 0x05, 0x69, 0x73,
-	0x69, 0x73, 0x78, 0x1a, 0x5f, 0x0a, 0x0f, 0x48, 0x61, 0x69, 0x72, 0x70, 0x69, 0x6e, 0x58, 0x43,
+	0x69, 0x73, 0x78, 0x12, 0x41, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x70, 0x61,
+	0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67,
+	0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x41, 0x0a, 0x0a, 0x69, 0x70, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x75, 0x6e,
+	0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x49, 0x70, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x69,
+	0x70, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x6c, 0x69, 0x6e,
+	0x75, 0x78, 0x43, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x75, 0x6e,
+	0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x43, 0x70, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x43, 0x70, 0x1a, 0x5f, 0x0a, 0x0f, 0x48, 0x61, 0x69, 0x72, 0x70, 0x69, 0x6e, 0x58, 0x43,
 	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x6e,
 	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
 	0x76, 0x70, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x31, 0x12, 0x25, 0x0a,
@@ -1526,126 +2669,275 @@ var file_puntmgr_puntmgr_proto_rawDesc = []byte{
 	0x70, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
 	0x77, 0x69, 0x74, 0x68, 0x44, 0x68, 0x63, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x10,
 	0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75,
-	0x1a, 0x2b, 0x0a, 0x04, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x70, 0x70, 0x5f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x76, 0x70, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x1a, 0xfc, 0x01,
-	0x0a, 0x03, 0x41, 0x62, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x70,
-	0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x72,
-	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x72, 0x66, 0x12, 0x26, 0x0a, 0x0f,
-	0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6e, 0x66, 0x5f, 0x76, 0x72, 0x66, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x6e,
-	0x66, 0x56, 0x72, 0x66, 0x12, 0x4b, 0x0a, 0x11, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f,
-	0x61, 0x63, 0x6c, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63, 0x6c,
-	0x2e, 0x41, 0x43, 0x4c, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x49, 0x70, 0x52, 0x75, 0x6c, 0x65,
-	0x52, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x41, 0x63, 0x6c, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x12, 0x49, 0x0a, 0x10, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x63, 0x6c, 0x5f,
-	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63, 0x6c, 0x2e, 0x41, 0x43, 0x4c,
-	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x49, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0e, 0x65, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x41, 0x63, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x87, 0x01, 0x0a,
-	0x0c, 0x50, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x31, 0x0a,
-	0x06, 0x74, 0x6f, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e,
-	0x54, 0x6f, 0x48, 0x6f, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x74, 0x6f, 0x48, 0x6f, 0x73, 0x74,
-	0x12, 0x3a, 0x0a, 0x09, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48,
-	0x00, 0x52, 0x09, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x45, 0x0a, 0x09, 0x44, 0x68, 0x63, 0x70, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x72, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x76, 0x72, 0x66, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
-	0x5f, 0x63, 0x6e, 0x66, 0x5f, 0x76, 0x72, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
-	0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x6e, 0x66, 0x56, 0x72, 0x66, 0x1a, 0x66, 0x0a,
-	0x05, 0x49, 0x73, 0x69, 0x73, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x6e,
+	0x1a, 0xe5, 0x01, 0x0a, 0x04, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x70, 0x70,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x76, 0x70, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x41,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x13, 0x0a, 0x05, 0x69, 0x73, 0x5f, 0x6c, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x69, 0x73, 0x4c, 0x32, 0x12, 0x39, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63, 0x6c, 0x2e, 0x41, 0x43, 0x4c, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x2e, 0x49, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x25, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08,
+	0x0a, 0x04, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x52, 0x58, 0x10, 0x01,
+	0x12, 0x06, 0x0a, 0x02, 0x54, 0x58, 0x10, 0x02, 0x1a, 0xad, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x2d, 0x0a, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e,
+	0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x70, 0x61, 0x6e,
+	0x52, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x12, 0x4b, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x70, 0x75,
+	0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x76,
+	0x72, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x56,
+	0x72, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x25, 0x0a, 0x0a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0a, 0x0a, 0x06, 0x45, 0x52, 0x53, 0x50, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x47,
+	0x52, 0x45, 0x5f, 0x54, 0x45, 0x42, 0x10, 0x01, 0x1a, 0xe3, 0x01, 0x0a, 0x0a, 0x49, 0x70, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x70, 0x70, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x76, 0x70, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x0b,
+	0x6c, 0x33, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2a, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x70, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x2e, 0x4c, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x0a, 0x6c,
+	0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x72, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x72, 0x66, 0x12, 0x26, 0x0a, 0x0f, 0x77,
+	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6e, 0x66, 0x5f, 0x76, 0x72, 0x66, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x6e, 0x66,
+	0x56, 0x72, 0x66, 0x22, 0x29, 0x0a, 0x0a, 0x4c, 0x33, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50,
+	0x56, 0x34, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36, 0x10, 0x02, 0x1a, 0xfc,
+	0x01, 0x0a, 0x03, 0x41, 0x62, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x6e,
 	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76,
 	0x70, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x76,
 	0x72, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x72, 0x66, 0x12, 0x26, 0x0a,
 	0x0f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6e, 0x66, 0x5f, 0x76, 0x72, 0x66,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43,
-	0x6e, 0x66, 0x56, 0x72, 0x66, 0x22, 0x7c, 0x0a, 0x08, 0x50, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x5f, 0x50, 0x55, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x14,
-	0x0a, 0x10, 0x48, 0x41, 0x49, 0x52, 0x50, 0x49, 0x4e, 0x5f, 0x58, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
-	0x43, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x41, 0x49, 0x52, 0x50, 0x49, 0x4e, 0x10,
-	0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x50, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x41,
-	0x42, 0x58, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f,
-	0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x48, 0x43, 0x50,
-	0x5f, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x53, 0x49, 0x53,
-	0x58, 0x10, 0x07, 0x22, 0x33, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x50, 0x10, 0x00,
-	0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x4d, 0x49, 0x46, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x41,
-	0x46, 0x5f, 0x55, 0x4e, 0x49, 0x58, 0x10, 0x02, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x22, 0x49, 0x0a, 0x0c, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x75, 0x6e, 0x74,
-	0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x0c, 0x70, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x52, 0x0a,
-	0x06, 0x50, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6e, 0x66, 0x5f, 0x6d,
-	0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
-	0x6e, 0x66, 0x4d, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x22, 0xd4, 0x04, 0x0a, 0x0c, 0x50, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x48, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x75, 0x6e,
-	0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x0d,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x95, 0x01,
-	0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x70, 0x68, 0x79, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x72, 0x66, 0x52, 0x54, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72, 0x66, 0x52, 0x54, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x72, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x72,
-	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x56, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x70, 0x70, 0x5f, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76,
-	0x70, 0x70, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6e,
-	0x66, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x6e, 0x66, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0xe8, 0x01,
-	0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x34,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x75, 0x6e,
-	0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x44,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x44, 0x0a, 0x0d, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x75,
-	0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0c, 0x76, 0x70,
-	0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x63, 0x6e,
-	0x66, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x52, 0x0c, 0x63, 0x6e, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x22, 0x71, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x31,
-	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x12, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x2a, 0x3c, 0x0a, 0x09, 0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
-	0x49, 0x4e, 0x49, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03,
-	0x32, 0x5b, 0x0a, 0x0b, 0x50, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12,
-	0x4c, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a,
-	0x1c, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x32, 0x5a,
-	0x30, 0x67, 0x6f, 0x2e, 0x70, 0x61, 0x6e, 0x74, 0x68, 0x65, 0x6f, 0x6e, 0x2e, 0x74, 0x65, 0x63,
-	0x68, 0x2f, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x3b, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67,
-	0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x66, 0x56, 0x72, 0x66, 0x12, 0x4b, 0x0a, 0x11, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x61, 0x63, 0x6c, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63,
+	0x6c, 0x2e, 0x41, 0x43, 0x4c, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x49, 0x70, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x41, 0x63, 0x6c, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x63, 0x6c,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63, 0x6c, 0x2e, 0x41, 0x43,
+	0x4c, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x49, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0e, 0x65,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x41, 0x63, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x8f, 0x01,
+	0x0a, 0x07, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x43, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x70, 0x70,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x76, 0x70, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x76, 0x72, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x72, 0x66,
+	0x12, 0x26, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6e, 0x66, 0x5f,
+	0x76, 0x72, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x43, 0x6e, 0x66, 0x56, 0x72, 0x66, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x1a,
+	0x87, 0x01, 0x0a, 0x0c, 0x50, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x31, 0x0a, 0x06, 0x74, 0x6f, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x75,
+	0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x48, 0x6f, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x74, 0x6f, 0x48,
+	0x6f, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x45, 0x0a, 0x09, 0x44, 0x68, 0x63,
+	0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x72, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x72, 0x66, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68,
+	0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6e, 0x66, 0x5f, 0x76, 0x72, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x6e, 0x66, 0x56, 0x72, 0x66,
+	0x1a, 0x66, 0x0a, 0x05, 0x49, 0x73, 0x69, 0x73, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x70, 0x70,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x76, 0x70, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x76, 0x72, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x72, 0x66,
+	0x12, 0x26, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6e, 0x66, 0x5f,
+	0x76, 0x72, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x43, 0x6e, 0x66, 0x56, 0x72, 0x66, 0x22, 0xac, 0x01, 0x0a, 0x08, 0x50, 0x75, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x5f, 0x50, 0x55, 0x4e, 0x54,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x41, 0x49, 0x52, 0x50, 0x49, 0x4e, 0x5f, 0x58, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x41, 0x49, 0x52,
+	0x50, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x50, 0x41, 0x4e, 0x10, 0x03, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x42, 0x58, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x55, 0x4e, 0x54,
+	0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a,
+	0x44, 0x48, 0x43, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05,
+	0x49, 0x53, 0x49, 0x53, 0x58, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x4d, 0x4f, 0x54,
+	0x45, 0x5f, 0x53, 0x50, 0x41, 0x4e, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x50, 0x5f, 0x52,
+	0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x09, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x49, 0x4e,
+	0x55, 0x58, 0x5f, 0x43, 0x50, 0x10, 0x0a, 0x22, 0x4e, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x54,
+	0x41, 0x50, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x4d, 0x49, 0x46, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x41, 0x46, 0x5f, 0x55, 0x4e, 0x49, 0x58, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
+	0x41, 0x46, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x41,
+	0x46, 0x5f, 0x58, 0x44, 0x50, 0x10, 0x04, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0x49, 0x0a, 0x0c, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x39, 0x0a, 0x0d, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d,
+	0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c,
+	0x70, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x52, 0x0a, 0x06,
+	0x50, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6e, 0x66, 0x5f, 0x6d, 0x73,
+	0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6e,
+	0x66, 0x4d, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x22, 0xe0, 0x06, 0x0a, 0x0c, 0x50, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x48, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x75, 0x6e, 0x74,
+	0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x0d, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x95, 0x01, 0x0a,
+	0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x68, 0x79, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x72, 0x66, 0x52, 0x54, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72, 0x66, 0x52, 0x54, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x72,
+	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x72, 0x66,
+	0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x56, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x70, 0x70, 0x5f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x70,
+	0x70, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6e, 0x66,
+	0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6e, 0x66, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0xd3, 0x01, 0x0a,
+	0x06, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x4b, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x70,
+	0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x2e, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x72, 0x63, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x76, 0x72, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x56, 0x72, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x1a, 0x9e, 0x02, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x12, 0x34, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x44, 0x0a, 0x0d, 0x76, 0x70, 0x70,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x52, 0x0c, 0x76, 0x70, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
+	0x44, 0x0a, 0x0d, 0x63, 0x6e, 0x66, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72,
+	0x2e, 0x50, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0c, 0x63, 0x6e, 0x66, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x06, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x06, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x22, 0x87, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70,
+	0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x15, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x75, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f,
+	0x6f, 0x6c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x22, 0x44, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xfc, 0x02, 0x0a, 0x08, 0x50, 0x75, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e,
+	0x50, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e,
+	0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x57, 0x0a, 0x14, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x52, 0x13, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x74, 0x0a, 0x12, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x34, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x64, 0x42, 0x79, 0x22, 0x42, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6e, 0x66, 0x5f, 0x6d, 0x73, 0x5f,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6e, 0x66,
+	0x4d, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x38, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x75, 0x6e, 0x74,
+	0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x70, 0x75,
+	0x6e, 0x74, 0x73, 0x22, 0x71, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6e, 0x66, 0x5f, 0x6d, 0x73, 0x5f, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6e, 0x66, 0x4d,
+	0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x69, 0x74, 0x68,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x77, 0x69, 0x74, 0x68, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x65, 0x0a, 0x09, 0x50, 0x75, 0x6e, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x70, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x72,
+	0x65, 0x76, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x09, 0x70, 0x72, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2a, 0x48, 0x0a,
+	0x09, 0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b,
+	0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0x9e, 0x02, 0x0a, 0x0b, 0x50, 0x75, 0x6e, 0x74,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x75, 0x6e,
+	0x74, 0x6d, 0x67, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67,
+	0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x3a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x15, 0x2e,
+	0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0a,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x75, 0x6e,
+	0x74, 0x6d, 0x67, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x75, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x2e, 0x50, 0x75, 0x6e,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x6f, 0x2e, 0x70,
+	0x61, 0x6e, 0x74, 0x68, 0x65, 0x6f, 0x6e, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x73, 0x74, 0x6f,
+	0x6e, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x75, 0x6e,
+	0x74, 0x6d, 0x67, 0x72, 0x3b, 0x70, 0x75, 0x6e, 0x74, 0x6d, 0x67, 0x72, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1660,63 +2952,105 @@ func file_puntmgr_puntmgr_proto_rawDescGZIP() []byte {
 	return file_puntmgr_puntmgr_proto_rawDescData
 }
 
-var file_puntmgr_puntmgr_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_puntmgr_puntmgr_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_puntmgr_puntmgr_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_puntmgr_puntmgr_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_puntmgr_puntmgr_proto_goTypes = []interface{}{
-	(PuntState)(0),                        // 0: puntmgr.PuntState
-	(PuntRequest_PuntType)(0),             // 1: puntmgr.PuntRequest.PuntType
-	(PuntRequest_InterconnectType)(0),     // 2: puntmgr.PuntRequest.InterconnectType
-	(*PuntRequest)(nil),                   // 3: puntmgr.PuntRequest
-	(*PuntRequests)(nil),                  // 4: puntmgr.PuntRequests
-	(*PuntID)(nil),                        // 5: puntmgr.PuntID
-	(*PuntMetadata)(nil),                  // 6: puntmgr.PuntMetadata
-	(*UpdatePuntStateReq)(nil),            // 7: puntmgr.UpdatePuntStateReq
-	(*UpdatePuntStateResp)(nil),           // 8: puntmgr.UpdatePuntStateResp
-	(*PuntRequest_HairpinXConnect)(nil),   // 9: puntmgr.PuntRequest.HairpinXConnect
-	(*PuntRequest_Hairpin)(nil),           // 10: puntmgr.PuntRequest.Hairpin
-	(*PuntRequest_Span)(nil),              // 11: puntmgr.PuntRequest.Span
-	(*PuntRequest_Abx)(nil),               // 12: puntmgr.PuntRequest.Abx
-	(*PuntRequest_PuntToSocket)(nil),      // 13: puntmgr.PuntRequest.PuntToSocket
-	(*PuntRequest_DhcpProxy)(nil),         // 14: puntmgr.PuntRequest.DhcpProxy
-	(*PuntRequest_Isisx)(nil),             // 15: puntmgr.PuntRequest.Isisx
-	(*PuntRequest_Hairpin_Interface)(nil), // 16: puntmgr.PuntRequest.Hairpin.Interface
-	(*PuntMetadata_Interface)(nil),        // 17: puntmgr.PuntMetadata.Interface
-	(*PuntMetadata_InterconnectID)(nil),   // 18: puntmgr.PuntMetadata.InterconnectID
-	(*PuntMetadata_Interconnect)(nil),     // 19: puntmgr.PuntMetadata.Interconnect
-	(*acl.ACL_Rule_IpRule)(nil),           // 20: ligato.vpp.acl.ACL.Rule.IpRule
-	(*punt.ToHost)(nil),                   // 21: ligato.vpp.punt.ToHost
-	(*punt.Exception)(nil),                // 22: ligato.vpp.punt.Exception
+	(PuntState)(0),                         // 0: puntmgr.PuntState
+	(PuntRequest_PuntType)(0),              // 1: puntmgr.PuntRequest.PuntType
+	(PuntRequest_InterconnectType)(0),      // 2: puntmgr.PuntRequest.InterconnectType
+	(PuntRequest_Span_Direction)(0),        // 3: puntmgr.PuntRequest.Span.Direction
+	(PuntRequest_RemoteSpan_TunnelType)(0), // 4: puntmgr.PuntRequest.RemoteSpan.TunnelType
+	(PuntRequest_IpRedirect_L3Protocol)(0), // 5: puntmgr.PuntRequest.IpRedirect.L3Protocol
+	(*PuntRequest)(nil),                    // 6: puntmgr.PuntRequest
+	(*PuntRequests)(nil),                   // 7: puntmgr.PuntRequests
+	(*PuntID)(nil),                         // 8: puntmgr.PuntID
+	(*PuntMetadata)(nil),                   // 9: puntmgr.PuntMetadata
+	(*UpdatePuntStateReq)(nil),             // 10: puntmgr.UpdatePuntStateReq
+	(*UpdatePuntStateResp)(nil),            // 11: puntmgr.UpdatePuntStateResp
+	(*SubnetPoolUsage)(nil),                // 12: puntmgr.SubnetPoolUsage
+	(*GetSubnetUsageReq)(nil),              // 13: puntmgr.GetSubnetUsageReq
+	(*GetSubnetUsageResp)(nil),             // 14: puntmgr.GetSubnetUsageResp
+	(*PuntInfo)(nil),                       // 15: puntmgr.PuntInfo
+	(*ListPuntsReq)(nil),                   // 16: puntmgr.ListPuntsReq
+	(*ListPuntsResp)(nil),                  // 17: puntmgr.ListPuntsResp
+	(*WatchPuntsReq)(nil),                  // 18: puntmgr.WatchPuntsReq
+	(*PuntEvent)(nil),                      // 19: puntmgr.PuntEvent
+	(*PuntRequest_HairpinXConnect)(nil),    // 20: puntmgr.PuntRequest.HairpinXConnect
+	(*PuntRequest_Hairpin)(nil),            // 21: puntmgr.PuntRequest.Hairpin
+	(*PuntRequest_Span)(nil),               // 22: puntmgr.PuntRequest.Span
+	(*PuntRequest_RemoteSpan)(nil),         // 23: puntmgr.PuntRequest.RemoteSpan
+	(*PuntRequest_IpRedirect)(nil),         // 24: puntmgr.PuntRequest.IpRedirect
+	(*PuntRequest_Abx)(nil),                // 25: puntmgr.PuntRequest.Abx
+	(*PuntRequest_LinuxCp)(nil),            // 26: puntmgr.PuntRequest.LinuxCp
+	(*PuntRequest_PuntToSocket)(nil),       // 27: puntmgr.PuntRequest.PuntToSocket
+	(*PuntRequest_DhcpProxy)(nil),          // 28: puntmgr.PuntRequest.DhcpProxy
+	(*PuntRequest_Isisx)(nil),              // 29: puntmgr.PuntRequest.Isisx
+	(*PuntRequest_Hairpin_Interface)(nil),  // 30: puntmgr.PuntRequest.Hairpin.Interface
+	(*PuntMetadata_Interface)(nil),         // 31: puntmgr.PuntMetadata.Interface
+	(*PuntMetadata_InterconnectID)(nil),    // 32: puntmgr.PuntMetadata.InterconnectID
+	(*PuntMetadata_Tunnel)(nil),            // 33: puntmgr.PuntMetadata.Tunnel
+	(*PuntMetadata_Interconnect)(nil),      // 34: puntmgr.PuntMetadata.Interconnect
+	(*PuntInfo_SharedInterconnect)(nil),    // 35: puntmgr.PuntInfo.SharedInterconnect
+	(*acl.ACL_Rule_IpRule)(nil),            // 36: ligato.vpp.acl.ACL.Rule.IpRule
+	(*punt.ToHost)(nil),                    // 37: ligato.vpp.punt.ToHost
+	(*punt.Exception)(nil),                 // 38: ligato.vpp.punt.Exception
 }
 var file_puntmgr_puntmgr_proto_depIdxs = []int32{
 	1,  // 0: puntmgr.PuntRequest.punt_type:type_name -> puntmgr.PuntRequest.PuntType
 	2,  // 1: puntmgr.PuntRequest.interconnect_type:type_name -> puntmgr.PuntRequest.InterconnectType
-	9,  // 2: puntmgr.PuntRequest.hairpinXConnect:type_name -> puntmgr.PuntRequest.HairpinXConnect
-	10, // 3: puntmgr.PuntRequest.hairpin:type_name -> puntmgr.PuntRequest.Hairpin
-	11, // 4: puntmgr.PuntRequest.span:type_name -> puntmgr.PuntRequest.Span
-	12, // 5: puntmgr.PuntRequest.abx:type_name -> puntmgr.PuntRequest.Abx
-	13, // 6: puntmgr.PuntRequest.puntToSocket:type_name -> puntmgr.PuntRequest.PuntToSocket
-	14, // 7: puntmgr.PuntRequest.dhcpProxy:type_name -> puntmgr.PuntRequest.DhcpProxy
-	15, // 8: puntmgr.PuntRequest.isisx:type_name -> puntmgr.PuntRequest.Isisx
-	3,  // 9: puntmgr.PuntRequests.punt_requests:type_name -> puntmgr.PuntRequest
-	5,  // 10: puntmgr.PuntMetadata.id:type_name -> puntmgr.PuntID
-	19, // 11: puntmgr.PuntMetadata.interconnects:type_name -> puntmgr.PuntMetadata.Interconnect
-	6,  // 12: puntmgr.UpdatePuntStateReq.metadata:type_name -> puntmgr.PuntMetadata
-	0,  // 13: puntmgr.UpdatePuntStateReq.state:type_name -> puntmgr.PuntState
-	16, // 14: puntmgr.PuntRequest.Hairpin.hairpin_interface:type_name -> puntmgr.PuntRequest.Hairpin.Interface
-	20, // 15: puntmgr.PuntRequest.Abx.ingress_acl_rules:type_name -> ligato.vpp.acl.ACL.Rule.IpRule
-	20, // 16: puntmgr.PuntRequest.Abx.egress_acl_rules:type_name -> ligato.vpp.acl.ACL.Rule.IpRule
-	21, // 17: puntmgr.PuntRequest.PuntToSocket.toHost:type_name -> ligato.vpp.punt.ToHost
-	22, // 18: puntmgr.PuntRequest.PuntToSocket.exception:type_name -> ligato.vpp.punt.Exception
-	18, // 19: puntmgr.PuntMetadata.Interconnect.id:type_name -> puntmgr.PuntMetadata.InterconnectID
-	17, // 20: puntmgr.PuntMetadata.Interconnect.vpp_interface:type_name -> puntmgr.PuntMetadata.Interface
-	17, // 21: puntmgr.PuntMetadata.Interconnect.cnf_interface:type_name -> puntmgr.PuntMetadata.Interface
-	7,  // 22: puntmgr.PuntManager.UpdatePuntState:input_type -> puntmgr.UpdatePuntStateReq
-	8,  // 23: puntmgr.PuntManager.UpdatePuntState:output_type -> puntmgr.UpdatePuntStateResp
-	23, // [23:24] is the sub-list for method output_type
-	22, // [22:23] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	20, // 2: puntmgr.PuntRequest.hairpinXConnect:type_name -> puntmgr.PuntRequest.HairpinXConnect
+	21, // 3: puntmgr.PuntRequest.hairpin:type_name -> puntmgr.PuntRequest.Hairpin
+	22, // 4: puntmgr.PuntRequest.span:type_name -> puntmgr.PuntRequest.Span
+	25, // 5: puntmgr.PuntRequest.abx:type_name -> puntmgr.PuntRequest.Abx
+	27, // 6: puntmgr.PuntRequest.puntToSocket:type_name -> puntmgr.PuntRequest.PuntToSocket
+	28, // 7: puntmgr.PuntRequest.dhcpProxy:type_name -> puntmgr.PuntRequest.DhcpProxy
+	29, // 8: puntmgr.PuntRequest.isisx:type_name -> puntmgr.PuntRequest.Isisx
+	23, // 9: puntmgr.PuntRequest.remoteSpan:type_name -> puntmgr.PuntRequest.RemoteSpan
+	24, // 10: puntmgr.PuntRequest.ipRedirect:type_name -> puntmgr.PuntRequest.IpRedirect
+	26, // 11: puntmgr.PuntRequest.linuxCp:type_name -> puntmgr.PuntRequest.LinuxCp
+	6,  // 12: puntmgr.PuntRequests.punt_requests:type_name -> puntmgr.PuntRequest
+	8,  // 13: puntmgr.PuntMetadata.id:type_name -> puntmgr.PuntID
+	34, // 14: puntmgr.PuntMetadata.interconnects:type_name -> puntmgr.PuntMetadata.Interconnect
+	9,  // 15: puntmgr.UpdatePuntStateReq.metadata:type_name -> puntmgr.PuntMetadata
+	0,  // 16: puntmgr.UpdatePuntStateReq.state:type_name -> puntmgr.PuntState
+	12, // 17: puntmgr.GetSubnetUsageResp.pools:type_name -> puntmgr.SubnetPoolUsage
+	9,  // 18: puntmgr.PuntInfo.metadata:type_name -> puntmgr.PuntMetadata
+	0,  // 19: puntmgr.PuntInfo.state:type_name -> puntmgr.PuntState
+	6,  // 20: puntmgr.PuntInfo.request:type_name -> puntmgr.PuntRequest
+	35, // 21: puntmgr.PuntInfo.shared_interconnects:type_name -> puntmgr.PuntInfo.SharedInterconnect
+	15, // 22: puntmgr.ListPuntsResp.punts:type_name -> puntmgr.PuntInfo
+	15, // 23: puntmgr.PuntEvent.punt:type_name -> puntmgr.PuntInfo
+	0,  // 24: puntmgr.PuntEvent.prev_state:type_name -> puntmgr.PuntState
+	30, // 25: puntmgr.PuntRequest.Hairpin.hairpin_interface:type_name -> puntmgr.PuntRequest.Hairpin.Interface
+	3,  // 26: puntmgr.PuntRequest.Span.direction:type_name -> puntmgr.PuntRequest.Span.Direction
+	36, // 27: puntmgr.PuntRequest.Span.filters:type_name -> ligato.vpp.acl.ACL.Rule.IpRule
+	22, // 28: puntmgr.PuntRequest.RemoteSpan.span:type_name -> puntmgr.PuntRequest.Span
+	4,  // 29: puntmgr.PuntRequest.RemoteSpan.tunnel_type:type_name -> puntmgr.PuntRequest.RemoteSpan.TunnelType
+	5,  // 30: puntmgr.PuntRequest.IpRedirect.l3_protocol:type_name -> puntmgr.PuntRequest.IpRedirect.L3Protocol
+	36, // 31: puntmgr.PuntRequest.Abx.ingress_acl_rules:type_name -> ligato.vpp.acl.ACL.Rule.IpRule
+	36, // 32: puntmgr.PuntRequest.Abx.egress_acl_rules:type_name -> ligato.vpp.acl.ACL.Rule.IpRule
+	37, // 33: puntmgr.PuntRequest.PuntToSocket.toHost:type_name -> ligato.vpp.punt.ToHost
+	38, // 34: puntmgr.PuntRequest.PuntToSocket.exception:type_name -> ligato.vpp.punt.Exception
+	4,  // 35: puntmgr.PuntMetadata.Tunnel.tunnel_type:type_name -> puntmgr.PuntRequest.RemoteSpan.TunnelType
+	32, // 36: puntmgr.PuntMetadata.Interconnect.id:type_name -> puntmgr.PuntMetadata.InterconnectID
+	31, // 37: puntmgr.PuntMetadata.Interconnect.vpp_interface:type_name -> puntmgr.PuntMetadata.Interface
+	31, // 38: puntmgr.PuntMetadata.Interconnect.cnf_interface:type_name -> puntmgr.PuntMetadata.Interface
+	33, // 39: puntmgr.PuntMetadata.Interconnect.tunnel:type_name -> puntmgr.PuntMetadata.Tunnel
+	32, // 40: puntmgr.PuntInfo.SharedInterconnect.id:type_name -> puntmgr.PuntMetadata.InterconnectID
+	8,  // 41: puntmgr.PuntInfo.SharedInterconnect.used_by:type_name -> puntmgr.PuntID
+	10, // 42: puntmgr.PuntManager.UpdatePuntState:input_type -> puntmgr.UpdatePuntStateReq
+	13, // 43: puntmgr.PuntManager.GetSubnetUsage:input_type -> puntmgr.GetSubnetUsageReq
+	16, // 44: puntmgr.PuntManager.ListPunts:input_type -> puntmgr.ListPuntsReq
+	18, // 45: puntmgr.PuntManager.WatchPunts:input_type -> puntmgr.WatchPuntsReq
+	11, // 46: puntmgr.PuntManager.UpdatePuntState:output_type -> puntmgr.UpdatePuntStateResp
+	14, // 47: puntmgr.PuntManager.GetSubnetUsage:output_type -> puntmgr.GetSubnetUsageResp
+	17, // 48: puntmgr.PuntManager.ListPunts:output_type -> puntmgr.ListPuntsResp
+	19, // 49: puntmgr.PuntManager.WatchPunts:output_type -> puntmgr.PuntEvent
+	46, // [46:50] is the sub-list for method output_type
+	42, // [42:46] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_puntmgr_puntmgr_proto_init() }
@@ -1798,7 +3132,7 @@ func file_puntmgr_puntmgr_proto_init() {
 			}
 		}
 		file_puntmgr_puntmgr_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PuntRequest_HairpinXConnect); i {
+			switch v := v.(*SubnetPoolUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1810,7 +3144,7 @@ func file_puntmgr_puntmgr_proto_init() {
 			}
 		}
 		file_puntmgr_puntmgr_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PuntRequest_Hairpin); i {
+			switch v := v.(*GetSubnetUsageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1822,7 +3156,7 @@ func file_puntmgr_puntmgr_proto_init() {
 			}
 		}
 		file_puntmgr_puntmgr_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PuntRequest_Span); i {
+			switch v := v.(*GetSubnetUsageResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1834,7 +3168,7 @@ func file_puntmgr_puntmgr_proto_init() {
 			}
 		}
 		file_puntmgr_puntmgr_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PuntRequest_Abx); i {
+			switch v := v.(*PuntInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1846,7 +3180,7 @@ func file_puntmgr_puntmgr_proto_init() {
 			}
 		}
 		file_puntmgr_puntmgr_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PuntRequest_PuntToSocket); i {
+			switch v := v.(*ListPuntsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1858,7 +3192,7 @@ func file_puntmgr_puntmgr_proto_init() {
 			}
 		}
 		file_puntmgr_puntmgr_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PuntRequest_DhcpProxy); i {
+			switch v := v.(*ListPuntsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1870,7 +3204,7 @@ func file_puntmgr_puntmgr_proto_init() {
 			}
 		}
 		file_puntmgr_puntmgr_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PuntRequest_Isisx); i {
+			switch v := v.(*WatchPuntsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1882,7 +3216,7 @@ func file_puntmgr_puntmgr_proto_init() {
 			}
 		}
 		file_puntmgr_puntmgr_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PuntRequest_Hairpin_Interface); i {
+			switch v := v.(*PuntEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1894,7 +3228,7 @@ func file_puntmgr_puntmgr_proto_init() {
 			}
 		}
 		file_puntmgr_puntmgr_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PuntMetadata_Interface); i {
+			switch v := v.(*PuntRequest_HairpinXConnect); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1906,7 +3240,7 @@ func file_puntmgr_puntmgr_proto_init() {
 			}
 		}
 		file_puntmgr_puntmgr_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PuntMetadata_InterconnectID); i {
+			switch v := v.(*PuntRequest_Hairpin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1918,6 +3252,150 @@ func file_puntmgr_puntmgr_proto_init() {
 			}
 		}
 		file_puntmgr_puntmgr_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntRequest_Span); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_puntmgr_puntmgr_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntRequest_RemoteSpan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_puntmgr_puntmgr_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntRequest_IpRedirect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_puntmgr_puntmgr_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntRequest_Abx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_puntmgr_puntmgr_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntRequest_LinuxCp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_puntmgr_puntmgr_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntRequest_PuntToSocket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_puntmgr_puntmgr_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntRequest_DhcpProxy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_puntmgr_puntmgr_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntRequest_Isisx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_puntmgr_puntmgr_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntRequest_Hairpin_Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_puntmgr_puntmgr_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntMetadata_Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_puntmgr_puntmgr_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntMetadata_InterconnectID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_puntmgr_puntmgr_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntMetadata_Tunnel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_puntmgr_puntmgr_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PuntMetadata_Interconnect); i {
 			case 0:
 				return &v.state
@@ -1929,6 +3407,18 @@ func file_puntmgr_puntmgr_proto_init() {
 				return nil
 			}
 		}
+		file_puntmgr_puntmgr_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuntInfo_SharedInterconnect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_puntmgr_puntmgr_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*PuntRequest_HairpinXConnect_)(nil),
@@ -1938,8 +3428,11 @@ func file_puntmgr_puntmgr_proto_init() {
 		(*PuntRequest_PuntToSocket_)(nil),
 		(*PuntRequest_DhcpProxy_)(nil),
 		(*PuntRequest_Isisx_)(nil),
+		(*PuntRequest_RemoteSpan_)(nil),
+		(*PuntRequest_IpRedirect_)(nil),
+		(*PuntRequest_LinuxCp_)(nil),
 	}
-	file_puntmgr_puntmgr_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_puntmgr_puntmgr_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*PuntRequest_PuntToSocket_ToHost)(nil),
 		(*PuntRequest_PuntToSocket_Exception)(nil),
 	}
@@ -1948,8 +3441,8 @@ func file_puntmgr_puntmgr_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_puntmgr_puntmgr_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   17,
+			NumEnums:      6,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,12 @@ const _ = grpc.SupportPackageIsVersion7
 type PuntManagerClient interface {
 	// UpdatePuntState is called by Punt Manager of StoneWork to notify SW-Module about state change of a punt.
 	UpdatePuntState(ctx context.Context, in *UpdatePuntStateReq, opts ...grpc.CallOption) (*UpdatePuntStateResp, error)
+	// GetSubnetUsage returns usage of CIDR pools from which subnets are allocated for interconnects.
+	GetSubnetUsage(ctx context.Context, in *GetSubnetUsageReq, opts ...grpc.CallOption) (*GetSubnetUsageResp, error)
+	// ListPunts returns all punts known to the Punt Manager, optionally filtered by CNF and key.
+	ListPunts(ctx context.Context, in *ListPuntsReq, opts ...grpc.CallOption) (*ListPuntsResp, error)
+	// WatchPunts streams state transitions of punts, optionally filtered by CNF and key.
+	WatchPunts(ctx context.Context, in *WatchPuntsReq, opts ...grpc.CallOption) (PuntManager_WatchPuntsClient, error)
 }
 
 type puntManagerClient struct {
@@ -43,12 +49,68 @@ func (c *puntManagerClient) UpdatePuntState(ctx context.Context, in *UpdatePuntS
 	return out, nil
 }
 
+func (c *puntManagerClient) GetSubnetUsage(ctx context.Context, in *GetSubnetUsageReq, opts ...grpc.CallOption) (*GetSubnetUsageResp, error) {
+	out := new(GetSubnetUsageResp)
+	err := c.cc.Invoke(ctx, "/puntmgr.PuntManager/GetSubnetUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *puntManagerClient) ListPunts(ctx context.Context, in *ListPuntsReq, opts ...grpc.CallOption) (*ListPuntsResp, error) {
+	out := new(ListPuntsResp)
+	err := c.cc.Invoke(ctx, "/puntmgr.PuntManager/ListPunts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *puntManagerClient) WatchPunts(ctx context.Context, in *WatchPuntsReq, opts ...grpc.CallOption) (PuntManager_WatchPuntsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PuntManager_ServiceDesc.Streams[0], "/puntmgr.PuntManager/WatchPunts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &puntManagerWatchPuntsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PuntManager_WatchPuntsClient interface {
+	Recv() (*PuntEvent, error)
+	grpc.ClientStream
+}
+
+type puntManagerWatchPuntsClient struct {
+	grpc.ClientStream
+}
+
+func (x *puntManagerWatchPuntsClient) Recv() (*PuntEvent, error) {
+	m := new(PuntEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PuntManagerServer is the server API for PuntManager service.
 // All implementations must embed UnimplementedPuntManagerServer
 // for forward compatibility
 type PuntManagerServer interface {
 	// UpdatePuntState is called by Punt Manager of StoneWork to notify SW-Module about state change of a punt.
 	UpdatePuntState(context.Context, *UpdatePuntStateReq) (*UpdatePuntStateResp, error)
+	// GetSubnetUsage returns usage of CIDR pools from which subnets are allocated for interconnects.
+	GetSubnetUsage(context.Context, *GetSubnetUsageReq) (*GetSubnetUsageResp, error)
+	// ListPunts returns all punts known to the Punt Manager, optionally filtered by CNF and key.
+	ListPunts(context.Context, *ListPuntsReq) (*ListPuntsResp, error)
+	// WatchPunts streams state transitions of punts, optionally filtered by CNF and key.
+	WatchPunts(*WatchPuntsReq, PuntManager_WatchPuntsServer) error
 	mustEmbedUnimplementedPuntManagerServer()
 }
 
@@ -59,6 +121,15 @@ type UnimplementedPuntManagerServer struct {
 func (UnimplementedPuntManagerServer) UpdatePuntState(context.Context, *UpdatePuntStateReq) (*UpdatePuntStateResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePuntState not implemented")
 }
+func (UnimplementedPuntManagerServer) GetSubnetUsage(context.Context, *GetSubnetUsageReq) (*GetSubnetUsageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubnetUsage not implemented")
+}
+func (UnimplementedPuntManagerServer) ListPunts(context.Context, *ListPuntsReq) (*ListPuntsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPunts not implemented")
+}
+func (UnimplementedPuntManagerServer) WatchPunts(*WatchPuntsReq, PuntManager_WatchPuntsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPunts not implemented")
+}
 func (UnimplementedPuntManagerServer) mustEmbedUnimplementedPuntManagerServer() {}
 
 // UnsafePuntManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -90,6 +161,63 @@ func _PuntManager_UpdatePuntState_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PuntManager_GetSubnetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubnetUsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PuntManagerServer).GetSubnetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/puntmgr.PuntManager/GetSubnetUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PuntManagerServer).GetSubnetUsage(ctx, req.(*GetSubnetUsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PuntManager_ListPunts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPuntsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PuntManagerServer).ListPunts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/puntmgr.PuntManager/ListPunts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PuntManagerServer).ListPunts(ctx, req.(*ListPuntsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PuntManager_WatchPunts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPuntsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PuntManagerServer).WatchPunts(m, &puntManagerWatchPuntsServer{stream})
+}
+
+type PuntManager_WatchPuntsServer interface {
+	Send(*PuntEvent) error
+	grpc.ServerStream
+}
+
+type puntManagerWatchPuntsServer struct {
+	grpc.ServerStream
+}
+
+func (x *puntManagerWatchPuntsServer) Send(m *PuntEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // PuntManager_ServiceDesc is the grpc.ServiceDesc for PuntManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -101,7 +229,21 @@ var PuntManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePuntState",
 			Handler:    _PuntManager_UpdatePuntState_Handler,
 		},
+		{
+			MethodName: "GetSubnetUsage",
+			Handler:    _PuntManager_GetSubnetUsage_Handler,
+		},
+		{
+			MethodName: "ListPunts",
+			Handler:    _PuntManager_ListPunts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPunts",
+			Handler:       _PuntManager_WatchPunts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "puntmgr/puntmgr.proto",
 }